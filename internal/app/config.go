@@ -19,6 +19,7 @@ type Config struct {
 	Cache     Cache
 	Messaging Messaging
 	SMTP      SMTP
+	Storage   Storage
 	App       *model.AppConfig
 }
 
@@ -68,6 +69,13 @@ func (c *Config) Setup() {
 		fmt.Println("SMTP is not enabled, skipping preparation")
 	}
 
+	err = c.Storage.prepare()
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	fmt.Println("Document storage prepared successfully")
+
 	util.SetValidator()
 }
 
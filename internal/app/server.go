@@ -25,6 +25,7 @@ import (
 	deliveryGRPC "github.com/ffauzann/loan-service/internal/delivery/grpc"
 	deliveryHTTP "github.com/ffauzann/loan-service/internal/delivery/http"
 	deliveryKafka "github.com/ffauzann/loan-service/internal/delivery/kafka"
+	deliveryWorker "github.com/ffauzann/loan-service/internal/delivery/worker"
 	"github.com/ffauzann/loan-service/internal/repository"
 	"github.com/ffauzann/loan-service/internal/service"
 	"github.com/ffauzann/loan-service/internal/util"
@@ -60,16 +61,17 @@ type HTTP struct {
 // The function uses goroutines to run the servers concurrently and waits for an interrupt signal to gracefully shut down the servers.
 func (c *Config) StartServer() {
 	var wg sync.WaitGroup
-	wg.Add(3) //nolint
+	wg.Add(4) //nolint
 
 	// Init repo
 	dbRepo := repository.NewDB(c.Database.SQL.DB, c.App, c.Server.Logger.Zap)
 	redisRepo := repository.NewRedis(c.Cache.Redis.Client, c.App, c.Server.Logger.Zap)
 	messagingRepo := repository.NewMessaging(c.Messaging.Kafka.Producer, c.App, c.Server.Logger.Zap)
 	notifRepo := repository.NewNotification(c.SMTP.MailHog.Client, c.App, c.Server.Logger.Zap)
+	documentRepo := repository.NewLocalDocument(c.Storage.Local.Dir, c.Storage.Local.BaseUrl, c.App, c.Server.Logger.Zap)
 
 	// Init service
-	svc := service.New(dbRepo, redisRepo, messagingRepo, notifRepo, c.App, c.Server.Logger.Zap)
+	svc := service.New(dbRepo, redisRepo, messagingRepo, notifRepo, documentRepo, c.App, c.Server.Logger.Zap)
 
 	go func() {
 		defer wg.Done()
@@ -86,6 +88,11 @@ func (c *Config) StartServer() {
 		c.startConsumer(svc)
 	}()
 
+	go func() {
+		defer wg.Done()
+		c.startWorker(svc)
+	}()
+
 	// Graceful shutdown
 	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
@@ -207,6 +214,11 @@ func (c *Config) startHTTPProxyServer(svc service.Service) {
 	}
 }
 
+// startWorker starts the background jobs such as expiring loans past their funding deadline.
+func (c *Config) startWorker(svc service.Service) {
+	deliveryWorker.New(svc, &c.App.Worker)
+}
+
 // startConsumer starts a Kafka consumer that listens for messages and processes them accordingly.
 func (c *Config) startConsumer(svc service.Service) {
 	consumer := c.Messaging.Kafka.Consumer
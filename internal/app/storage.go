@@ -0,0 +1,22 @@
+package app
+
+import (
+	"os"
+)
+
+type Storage struct {
+	Local LocalStorage
+}
+
+type LocalStorage struct {
+	Dir     string // Directory documents are written to.
+	BaseUrl string // URL the directory is served from. Empty links documents with file:// URLs.
+}
+
+func (s *Storage) prepare() error {
+	return s.Local.prepare()
+}
+
+func (l *LocalStorage) prepare() error {
+	return os.MkdirAll(l.Dir, 0o750) //nolint
+}
@@ -3,6 +3,7 @@ package constant
 import (
 	"errors"
 
+	"github.com/ffauzann/loan-service/internal/money"
 	"google.golang.org/grpc/codes"
 )
 
@@ -26,10 +27,47 @@ var (
 	ErrUserAlreadyExists          = errors.New("User already exists")
 	ErrInvalidToken               = errors.New("Invalid/expired token")
 	ErrUnspecifiedAction          = errors.New("Unspecified Action")
-	ErrLoanNotApproved            = errors.New("Loan not approved")
 	ErrInvestmentAmountOutOfRange = errors.New("Investment amount out of range")
-	ErrLoanNotProposed            = errors.New("Loan not proposed")
-	ErrLoanNotFullyInvested       = errors.New("Loan not fully invested")
+	ErrPrincipalAmountOutOfRange  = errors.New("Principal amount out of range")
+	ErrUnsupportedCurrency        = errors.New("Unsupported currency")
+	ErrCurrencyMismatch           = errors.New("Investment currency does not match the loan currency")
+	ErrCreditLimitExceeded        = errors.New("Borrower credit limit exceeded")
+	ErrNotABorrower               = errors.New("User is not a borrower")
+	ErrLoanShareExceeded          = errors.New("Investment exceeds the maximum share of a loan per investor")
+	ErrBorrowerExposureExceeded   = errors.New("Investment exceeds the maximum exposure to a single borrower")
+	ErrLoanNotFound               = errors.New("Loan not found")
+	ErrIllegalLoanTransition      = errors.New("Illegal loan state transition")
+	ErrLoanNotPrepayable          = errors.New("Loan is not eligible for prepayment")
+	ErrInvalidPrepaymentAmount    = errors.New("Invalid prepayment amount")
+	ErrInvalidQuoteDate           = errors.New("Quote date must not be in the past")
+	ErrLoanRestructurePending     = errors.New("Loan already has a pending restructure")
+	ErrInvalidRestructure         = errors.New("Restructure must change the tenor, interest rate or grace period")
+	ErrRestructureNotFound        = errors.New("Loan restructure not found")
+	ErrRestructureNotPending      = errors.New("Loan restructure was already reviewed")
+	ErrRestructureSelfReview      = errors.New("Loan restructure must be reviewed by another admin")
+	ErrAutoInvestRuleNotFound     = errors.New("Auto invest rule not found")
+	ErrReservationNotFound        = errors.New("Investment reservation not found")
+	ErrReservationNotHeld         = errors.New("Investment reservation is no longer held")
+	ErrReservationExpired         = errors.New("Investment reservation has expired")
+	ErrInvestmentNotFound         = errors.New("Investment not found")
+	ErrInvestmentNotActive        = errors.New("Investment is no longer active")
+	ErrCoolingOffPeriodOver       = errors.New("Cooling-off period of the investment is over")
+	ErrLoanNotTradable            = errors.New("Loan investments can only be traded while the loan is disbursed or repaying")
+	ErrInvalidListingAmount       = errors.New("Listing amount must leave both the sold part and the remainder within the investment limits")
+	ErrInvestmentAlreadyListed    = errors.New("Investment already has an open listing")
+	ErrListingNotFound            = errors.New("Investment listing not found")
+	ErrListingNotOpen             = errors.New("Investment listing is no longer open")
+	ErrOwnListing                 = errors.New("Investors cannot buy their own listing")
+	ErrAgreementNotGenerated      = errors.New("Loan agreement has not been generated yet")
+	ErrAgreementMismatch          = errors.New("Signed agreement link does not match the generated loan agreement")
+	ErrInvalidLoanFilter          = errors.New("Loan filter has an unknown state or a reversed range")
+	ErrInvalidCursor              = errors.New("Invalid or expired pagination cursor")
+	ErrApprovalTermsRequired      = errors.New("First approval step requires a photo proof, an interest rate and an ROI")
+	ErrApprovalStepRole           = errors.New("Role is not allowed to approve the current approval step")
+	ErrDuplicateApprover          = errors.New("Each approval step of a loan must be done by a different user")
+	ErrLoanNotAssigned            = errors.New("Loan is not assigned to this field validator")
+	ErrLoanNotAssignable          = errors.New("Only loans awaiting a field validator approval can be assigned")
+	ErrInvalidAssignee            = errors.New("Loans can only be assigned to an active field validator")
 )
 
 // All client-safe errors goes here.
@@ -40,15 +78,54 @@ var (
 		ErrInvalidUsernamePassword:    codes.InvalidArgument,
 		ErrMalformedEmail:             codes.InvalidArgument,
 		ErrInvalidUserIdType:          codes.InvalidArgument,
+		money.ErrMalformedAmount:      codes.InvalidArgument,
+		money.ErrAmountPrecision:      codes.InvalidArgument,
 		ErrUnspecifiedAction:          codes.FailedPrecondition,
 		ErrPasswordIsTooWeak:          codes.FailedPrecondition,
 		ErrNoArg:                      codes.FailedPrecondition,
-		ErrLoanNotApproved:            codes.FailedPrecondition,
 		ErrInvestmentAmountOutOfRange: codes.FailedPrecondition,
-		ErrLoanNotProposed:            codes.FailedPrecondition,
-		ErrLoanNotFullyInvested:       codes.FailedPrecondition,
+		ErrPrincipalAmountOutOfRange:  codes.InvalidArgument,
+		ErrUnsupportedCurrency:        codes.InvalidArgument,
+		ErrCurrencyMismatch:           codes.FailedPrecondition,
+		ErrCreditLimitExceeded:        codes.FailedPrecondition,
+		ErrNotABorrower:               codes.InvalidArgument,
+		ErrLoanShareExceeded:          codes.FailedPrecondition,
+		ErrBorrowerExposureExceeded:   codes.FailedPrecondition,
+		ErrIllegalLoanTransition:      codes.FailedPrecondition,
+		ErrLoanNotPrepayable:          codes.FailedPrecondition,
+		ErrInvalidPrepaymentAmount:    codes.FailedPrecondition,
+		ErrInvalidQuoteDate:           codes.InvalidArgument,
+		ErrLoanRestructurePending:     codes.FailedPrecondition,
+		ErrInvalidRestructure:         codes.InvalidArgument,
+		ErrRestructureNotFound:        codes.NotFound,
+		ErrRestructureNotPending:      codes.FailedPrecondition,
+		ErrRestructureSelfReview:      codes.PermissionDenied,
+		ErrAutoInvestRuleNotFound:     codes.NotFound,
+		ErrReservationNotFound:        codes.NotFound,
+		ErrReservationNotHeld:         codes.FailedPrecondition,
+		ErrReservationExpired:         codes.FailedPrecondition,
+		ErrInvestmentNotFound:         codes.NotFound,
+		ErrInvestmentNotActive:        codes.FailedPrecondition,
+		ErrCoolingOffPeriodOver:       codes.FailedPrecondition,
+		ErrLoanNotTradable:            codes.FailedPrecondition,
+		ErrInvalidListingAmount:       codes.InvalidArgument,
+		ErrInvestmentAlreadyListed:    codes.FailedPrecondition,
+		ErrListingNotFound:            codes.NotFound,
+		ErrListingNotOpen:             codes.FailedPrecondition,
+		ErrOwnListing:                 codes.FailedPrecondition,
+		ErrAgreementNotGenerated:      codes.FailedPrecondition,
+		ErrAgreementMismatch:          codes.InvalidArgument,
+		ErrInvalidLoanFilter:          codes.InvalidArgument,
+		ErrInvalidCursor:              codes.InvalidArgument,
+		ErrApprovalTermsRequired:      codes.InvalidArgument,
+		ErrApprovalStepRole:           codes.PermissionDenied,
+		ErrDuplicateApprover:          codes.FailedPrecondition,
+		ErrLoanNotAssigned:            codes.PermissionDenied,
+		ErrLoanNotAssignable:          codes.FailedPrecondition,
+		ErrInvalidAssignee:            codes.InvalidArgument,
 		ErrNotFound:                   codes.NotFound,
 		ErrUserNotFound:               codes.NotFound,
+		ErrLoanNotFound:               codes.NotFound,
 		ErrUserAlreadyExists:          codes.AlreadyExists,
 		ErrPermissionDenied:           codes.PermissionDenied,
 		ErrUserIsNotActive:            codes.PermissionDenied,
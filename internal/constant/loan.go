@@ -1,17 +1,205 @@
 package constant
 
+import (
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/money"
+)
+
 // LoanState represents the state of a loan in the system.
 type LoanState string
 
 const (
-	LoanStateProposed  LoanState = "PROPOSED"
-	LoanStateApproved  LoanState = "APPROVED"
-	LoanStateFunding   LoanState = "FUNDING"
-	LoanStateInvested  LoanState = "INVESTED"
-	LoanStateDisbursed LoanState = "DISBURSED"
+	LoanStateProposed   LoanState = "PROPOSED"
+	LoanStateApproved   LoanState = "APPROVED"
+	LoanStateRejected   LoanState = "REJECTED"
+	LoanStateCancelled  LoanState = "CANCELLED"
+	LoanStateExpired    LoanState = "EXPIRED"
+	LoanStateFunding    LoanState = "FUNDING"
+	LoanStateInvested   LoanState = "INVESTED"
+	LoanStateDisbursed  LoanState = "DISBURSED"
+	LoanStateRepaying   LoanState = "REPAYING"
+	LoanStateClosed     LoanState = "CLOSED"
+	LoanStateDefaulted  LoanState = "DEFAULTED"
+	LoanStateWrittenOff LoanState = "WRITTEN_OFF"
+)
+
+// LoanRejectionReason represents the reason code given by a field validator when rejecting a loan.
+type LoanRejectionReason string
+
+const (
+	LoanRejectionReasonIncompleteDocuments LoanRejectionReason = "INCOMPLETE_DOCUMENTS"
+	LoanRejectionReasonFailedVerification  LoanRejectionReason = "FAILED_VERIFICATION"
+	LoanRejectionReasonInsufficientIncome  LoanRejectionReason = "INSUFFICIENT_INCOME"
+	LoanRejectionReasonFraudSuspected      LoanRejectionReason = "FRAUD_SUSPECTED"
+	LoanRejectionReasonOther               LoanRejectionReason = "OTHER"
+)
+
+// LoanInvestmentStatus represents the status of a single investment in a loan.
+type LoanInvestmentStatus string
+
+const (
+	LoanInvestmentStatusActive      LoanInvestmentStatus = "ACTIVE"
+	LoanInvestmentStatusReversed    LoanInvestmentStatus = "REVERSED"
+	LoanInvestmentStatusRefunded    LoanInvestmentStatus = "REFUNDED"
+	LoanInvestmentStatusCancelled   LoanInvestmentStatus = "CANCELLED"
+	LoanInvestmentStatusTransferred LoanInvestmentStatus = "TRANSFERRED"
+)
+
+// LoanInvestmentReservationStatus represents the status of a hold on the remaining capacity of a loan.
+type LoanInvestmentReservationStatus string
+
+const (
+	LoanInvestmentReservationStatusHeld      LoanInvestmentReservationStatus = "HELD"
+	LoanInvestmentReservationStatusConfirmed LoanInvestmentReservationStatus = "CONFIRMED"
+	LoanInvestmentReservationStatusExpired   LoanInvestmentReservationStatus = "EXPIRED"
+)
+
+// InvestmentListingStatus represents the status of a position offered on the secondary market.
+type InvestmentListingStatus string
+
+const (
+	InvestmentListingStatusOpen      InvestmentListingStatus = "OPEN"
+	InvestmentListingStatusSold      InvestmentListingStatus = "SOLD"
+	InvestmentListingStatusCancelled InvestmentListingStatus = "CANCELLED"
+)
+
+// LoanAgreementType represents the party a generated loan agreement is addressed to.
+type LoanAgreementType string
+
+const (
+	LoanAgreementTypeBorrower LoanAgreementType = "BORROWER"
+	LoanAgreementTypeLender   LoanAgreementType = "LENDER"
+)
+
+// LoanInstallmentStatus represents the repayment status of a single installment.
+type LoanInstallmentStatus string
+
+const (
+	LoanInstallmentStatusUnpaid        LoanInstallmentStatus = "UNPAID"
+	LoanInstallmentStatusPartiallyPaid LoanInstallmentStatus = "PARTIALLY_PAID"
+	LoanInstallmentStatusPaid          LoanInstallmentStatus = "PAID"
+)
+
+// LoanDelinquencyBucket groups loans by how many days their oldest unpaid installment is past due.
+type LoanDelinquencyBucket string
+
+const (
+	LoanDelinquencyBucketCurrent LoanDelinquencyBucket = "CURRENT"
+	LoanDelinquencyBucket1To30   LoanDelinquencyBucket = "DPD_1_30"
+	LoanDelinquencyBucket31To60  LoanDelinquencyBucket = "DPD_31_60"
+	LoanDelinquencyBucket61To90  LoanDelinquencyBucket = "DPD_61_90"
+	LoanDelinquencyBucket90Plus  LoanDelinquencyBucket = "DPD_90_PLUS"
+)
+
+// PrepaymentType represents whether a prepayment pays the whole loan off or only part of its principal.
+type PrepaymentType string
+
+const (
+	PrepaymentTypeFull    PrepaymentType = "FULL"
+	PrepaymentTypePartial PrepaymentType = "PARTIAL"
+)
+
+// PrepaymentStrategy represents how the remaining schedule is recalculated after a partial prepayment.
+type PrepaymentStrategy string
+
+const (
+	PrepaymentStrategyReduceTenor       PrepaymentStrategy = "REDUCE_TENOR"       // Keep the installment amount, drop the last installments.
+	PrepaymentStrategyReduceInstallment PrepaymentStrategy = "REDUCE_INSTALLMENT" // Keep the tenor, lower every remaining installment.
+)
+
+// LoanRestructureStatus represents the review status of a loan restructure.
+type LoanRestructureStatus string
+
+const (
+	LoanRestructureStatusPending  LoanRestructureStatus = "PENDING"
+	LoanRestructureStatusApproved LoanRestructureStatus = "APPROVED"
+	LoanRestructureStatusRejected LoanRestructureStatus = "REJECTED"
+)
+
+// LoanSortField represents the field a loan listing is ordered by.
+type LoanSortField string
+
+const (
+	LoanSortFieldCreatedAt       LoanSortField = "created_at"
+	LoanSortFieldPrincipalAmount LoanSortField = "principal_amount"
+	LoanSortFieldInterestRate    LoanSortField = "interest_rate"
+)
+
+// LoanRiskGrade represents the risk assessed by the field validator on approval, from A (lowest risk) to E.
+// Grades compare as strings, a higher grade being a riskier loan.
+type LoanRiskGrade string
+
+const (
+	LoanRiskGradeA LoanRiskGrade = "A"
+	LoanRiskGradeB LoanRiskGrade = "B"
+	LoanRiskGradeC LoanRiskGrade = "C"
+	LoanRiskGradeD LoanRiskGrade = "D"
+	LoanRiskGradeE LoanRiskGrade = "E"
+)
+
+// DayCountConvention represents how the daily interest accrual converts an annual rate to a single day.
+type DayCountConvention string
+
+const (
+	DayCountConventionAct365 DayCountConvention = "ACT/365" // Every calendar day accrues 1/365 of the annual rate.
+	DayCountConvention30360  DayCountConvention = "30/360"  // Every month counts as 30 days of a 360-day year.
+)
+
+// LoanAssignmentStatus represents the status of the assignment of a proposed loan to a field validator.
+type LoanAssignmentStatus string
+
+const (
+	LoanAssignmentStatusAssigned   LoanAssignmentStatus = "ASSIGNED"   // The validator is in charge of the loan.
+	LoanAssignmentStatusCompleted  LoanAssignmentStatus = "COMPLETED"  // The loan was approved or rejected.
+	LoanAssignmentStatusReassigned LoanAssignmentStatus = "REASSIGNED" // An admin handed the loan over to another validator.
+)
+
+// LoanAssignmentStrategy represents how proposed loans are spread across field validators.
+type LoanAssignmentStrategy string
+
+const (
+	LoanAssignmentStrategyRoundRobin  LoanAssignmentStrategy = "ROUND_ROBIN"  // Validators take turns in ID order.
+	LoanAssignmentStrategyLeastLoaded LoanAssignmentStrategy = "LEAST_LOADED" // The validator with the fewest pending loans, ties broken by ID.
+)
+
+const (
+	LoanDefaultDaysPastDue uint32 = 90 // Loans past due longer than this are moved to DEFAULTED.
+)
+
+const (
+	DefaultLoanTenorMonths uint32 = 12 // Used when the borrower does not request a tenor.
+	MaxLoanTenorMonths     uint32 = 60 // Longest tenor a borrower may request.
+	MaxGracePeriodMonths   uint32 = 12 // Longest interest-only period a restructure may add.
+)
+
+const (
+	DefaultLoanPageSize      uint32 = 20  // Used when a loan listing does not request a page size.
+	MaxLoanPageSize          uint32 = 100 // Larger loan listing page sizes are clamped to it.
+	DefaultPortfolioPageSize uint32 = 100 // Used when a portfolio request does not request a page size.
+	MaxPortfolioPageSize     uint32 = 500 // Larger portfolio page sizes are clamped to it.
+)
+
+const (
+	DefaultFundingPeriod     = 30 * 24 * time.Hour // Used when app.loan.fundingPeriod is not configured.
+	DefaultReservationPeriod = 15 * time.Minute    // Used when app.loan.reservationPeriod is not configured.
+	DefaultCoolingOffPeriod  = 24 * time.Hour      // Used when app.loan.coolingOffPeriod is not configured.
+)
+
+const (
+	DefaultDayCountConvention     = DayCountConventionAct365          // Used when app.loan.dayCountConvention is not configured.
+	DefaultLoanAssignmentStrategy = LoanAssignmentStrategyLeastLoaded // Used when app.loan.assignmentStrategy is not configured.
+)
+
+const (
+	DefaultCurrency = "IDR" // Used when a loan or an investment does not specify a currency.
 )
 
+// Limits of DefaultCurrency, used when app.loan.currencies is not configured.
 const (
-	MinInvestmentAmount float64 = 1_000.0       // Minimum investment amount in the system.
-	MaxInvestmentAmount float64 = 100_000_000.0 // Maximum investment amount in the system.
+	MinPrincipalAmount  money.Amount = 1_000 * money.Unit       // Minimum principal of a loan.
+	MaxPrincipalAmount  money.Amount = 100_000_000 * money.Unit // Maximum principal of a loan.
+	MinInvestmentAmount money.Amount = 1_000 * money.Unit       // Minimum investment amount in the system.
+	MaxInvestmentAmount money.Amount = 100_000_000 * money.Unit // Maximum investment amount in the system.
+	DefaultCreditLimit  money.Amount = 100_000_000 * money.Unit // Credit limit of a borrower without one set.
 )
@@ -2,11 +2,22 @@ package constant
 
 // Topics.
 const (
-	TopicFullyInvested = "loan-service.fully-invested"
+	TopicFullyInvested    = "loan-service.fully-invested"
+	TopicLoanCancelled    = "loan-service.loan-cancelled"
+	TopicLoanExpired      = "loan-service.loan-expired"
+	TopicLoanDelinquent   = "loan-service.loan-delinquent"
+	TopicLoanRestructured = "loan-service.loan-restructured"
+
+	// Published for downstream services (e.g. wallet), not consumed by this service.
+	TopicInvestorPayout = "loan-service.investor-payout"
 )
 
 var (
 	ConsumerGroupTopics = []string{
 		TopicFullyInvested,
+		TopicLoanCancelled,
+		TopicLoanExpired,
+		TopicLoanDelinquent,
+		TopicLoanRestructured,
 	}
 )
@@ -9,6 +9,9 @@ const (
 	RoleIdBorrower
 )
 
+// RoleIdSystem triggers the transitions run by background jobs, it is never assigned to a user.
+const RoleIdSystem uint8 = 0
+
 // RBAC.
 var (
 	// Registration.
@@ -21,12 +24,63 @@ var (
 	// Propose loan.
 	AllowedRolesProposeLoan = []uint8{RoleIdSuperadmin, RoleIdAdmin, RoleIdBorrower}
 
+	// Cancel loan.
+	AllowedRolesCancelLoan = []uint8{RoleIdBorrower}
+
 	// Approve loan.
 	AllowedRolesApproveLoan = []uint8{RoleIdSuperadmin, RoleIdAdmin, RoleIdFieldValidator}
 
+	// Loan assignments, field validators only ever see their own.
+	AllowedRolesViewAssignments = []uint8{RoleIdFieldValidator}
+	AllowedRolesReassignLoan    = []uint8{RoleIdSuperadmin, RoleIdAdmin}
+
+	// View loans. Borrowers see their own loans, investors the marketplace and the loans they hold.
+	AllowedRolesViewLoan = []uint8{RoleIdSuperadmin, RoleIdAdmin, RoleIdFieldValidator, RoleIdInvestor, RoleIdBorrower}
+
 	// Invest loan.
 	AllowedRolesInvestLoan = []uint8{RoleIdSuperadmin, RoleIdAdmin, RoleIdInvestor}
 
+	// Cancel investment within the cooling-off period, investors are limited to their own investments.
+	AllowedRolesCancelInvestment = []uint8{RoleIdInvestor}
+
+	// Trade investments on the secondary market, investors are limited to their own positions.
+	// Listings are public to investors, transfers are further limited to the ones an investor took part in.
+	AllowedRolesTradeInvestment     = []uint8{RoleIdInvestor}
+	AllowedRolesViewSecondaryMarket = []uint8{RoleIdSuperadmin, RoleIdAdmin, RoleIdInvestor}
+
+	// Manage auto invest rules, investors only ever see their own.
+	AllowedRolesManageAutoInvest = []uint8{RoleIdInvestor}
+
+	// View portfolio.
+	AllowedRolesViewPortfolio = []uint8{RoleIdInvestor}
+
 	// Disburse loan.
 	AllowedRolesDisburseLoan = []uint8{RoleIdSuperadmin, RoleIdAdmin}
+
+	// Write off loan.
+	AllowedRolesWriteOffLoan = []uint8{RoleIdSuperadmin, RoleIdAdmin}
+
+	// Restructure loan. A restructure must be reviewed by another admin than its requester.
+	AllowedRolesRestructureLoan       = []uint8{RoleIdSuperadmin, RoleIdAdmin}
+	AllowedRolesReviewLoanRestructure = []uint8{RoleIdSuperadmin, RoleIdAdmin}
+
+	// Record repayment. Borrowers are further limited to their own loans.
+	AllowedRolesRecordRepayment = []uint8{RoleIdSuperadmin, RoleIdAdmin, RoleIdBorrower}
+
+	// Prepay loan and view payoff quote. Borrowers are further limited to their own loans.
+	AllowedRolesPrepayLoan      = []uint8{RoleIdSuperadmin, RoleIdAdmin, RoleIdBorrower}
+	AllowedRolesViewPayoffQuote = []uint8{RoleIdSuperadmin, RoleIdAdmin, RoleIdBorrower}
+
+	// View repayment schedule. Borrowers and investors are further limited to their own loans.
+	AllowedRolesViewRepaymentSchedule = []uint8{RoleIdSuperadmin, RoleIdAdmin, RoleIdInvestor, RoleIdBorrower}
+
+	// View and adjust borrower credit limits.
+	AllowedRolesViewCreditLimit   = []uint8{RoleIdSuperadmin, RoleIdAdmin}
+	AllowedRolesManageCreditLimit = []uint8{RoleIdSuperadmin, RoleIdAdmin}
+
+	// View loan delinquency. Borrowers are further limited to their own loans.
+	AllowedRolesViewLoanDelinquency = []uint8{RoleIdSuperadmin, RoleIdAdmin, RoleIdBorrower}
+
+	// View accrued interest. Borrowers and investors are further limited to their own loans.
+	AllowedRolesViewAccruedInterest = []uint8{RoleIdSuperadmin, RoleIdAdmin, RoleIdInvestor, RoleIdBorrower}
 )
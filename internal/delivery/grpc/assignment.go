@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/ffauzann/loan-service/proto/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListMyAssignments handles the listing of the proposed loans awaiting the review of a field validator.
+// nolint
+func (s *srv) ListMyAssignments(ctx context.Context, req *gen.ListMyAssignmentsRequest) (res *gen.ListMyAssignmentsResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.ListMyAssignmentsRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesViewAssignments, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set ValidatorId from claims.
+	param.ValidatorId = claims.UserId
+
+	// Begin core process for the request.
+	result, err := s.service.ListMyAssignments(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.ListMyAssignmentsResponse{
+		Assignments: make([]*gen.PendingAssignment, 0, len(result.Assignments)),
+	}
+	for _, assignment := range result.Assignments {
+		res.Assignments = append(res.Assignments, &gen.PendingAssignment{
+			AssignmentId:    assignment.AssignmentId,
+			LoanId:          assignment.LoanId,
+			BorrowerId:      assignment.BorrowerId,
+			PrincipalAmount: assignment.PrincipalAmount.String(),
+			Currency:        assignment.Currency,
+			TenorMonths:     assignment.TenorMonths,
+			ProposedAt:      timestamppb.New(assignment.ProposedAt),
+			AssignedAt:      timestamppb.New(assignment.AssignedAt),
+		})
+	}
+
+	return
+}
+
+// ReassignLoan handles the manual reassignment of a proposed loan to another field validator.
+// nolint
+func (s *srv) ReassignLoan(ctx context.Context, req *gen.ReassignLoanRequest) (res *gen.ReassignLoanResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.ReassignLoanRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesReassignLoan, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set AdminId from claims.
+	param.AdminId = claims.UserId
+
+	// Begin core process for the request.
+	result, err := s.service.ReassignLoan(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.ReassignLoanResponse{
+		Assignment: loanAssignment(result.Assignment),
+	}
+
+	return
+}
+
+func loanAssignment(assignment *model.LoanAssignment) *gen.LoanAssignment {
+	res := &gen.LoanAssignment{
+		AssignmentId: assignment.Id,
+		LoanId:       assignment.LoanId,
+		ValidatorId:  assignment.ValidatorId,
+		Status:       string(assignment.Status),
+		AssignedAt:   timestamppb.New(assignment.AssignedAt),
+	}
+	if assignment.ClosedAt.Valid {
+		res.ClosedAt = timestamppb.New(assignment.ClosedAt.Time)
+	}
+
+	return res
+}
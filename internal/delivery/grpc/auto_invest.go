@@ -0,0 +1,168 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/ffauzann/loan-service/proto/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateAutoInvestRule handles the creation of an auto invest rule of an investor.
+// nolint
+func (s *srv) CreateAutoInvestRule(ctx context.Context, req *gen.CreateAutoInvestRuleRequest) (res *gen.CreateAutoInvestRuleResponse, err error) {
+	// Cast and validate request.
+	param, err := util.DecodeStruct[model.CreateAutoInvestRuleRequest](req)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesManageAutoInvest, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set InvestorId from claims.
+	param.InvestorId = claims.UserId
+
+	// Begin core process for the request.
+	result, err := s.service.CreateAutoInvestRule(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.CreateAutoInvestRuleResponse{
+		Rule: autoInvestRule(result.Rule),
+	}
+
+	return
+}
+
+// ListAutoInvestRules handles the listing of the auto invest rules of an investor.
+// nolint
+func (s *srv) ListAutoInvestRules(ctx context.Context, req *gen.ListAutoInvestRulesRequest) (res *gen.ListAutoInvestRulesResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.ListAutoInvestRulesRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesManageAutoInvest, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set InvestorId from claims.
+	param.InvestorId = claims.UserId
+
+	// Begin core process for the request.
+	result, err := s.service.ListAutoInvestRules(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.ListAutoInvestRulesResponse{
+		Rules: make([]*gen.AutoInvestRule, 0, len(result.Rules)),
+	}
+	for _, rule := range result.Rules {
+		res.Rules = append(res.Rules, autoInvestRule(rule))
+	}
+
+	return
+}
+
+// DeleteAutoInvestRule handles the deletion of an auto invest rule of an investor.
+// nolint
+func (s *srv) DeleteAutoInvestRule(ctx context.Context, req *gen.DeleteAutoInvestRuleRequest) (res *gen.DeleteAutoInvestRuleResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.DeleteAutoInvestRuleRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesManageAutoInvest, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set InvestorId from claims.
+	param.InvestorId = claims.UserId
+
+	// Begin core process for the request.
+	result, err := s.service.DeleteAutoInvestRule(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.DeleteAutoInvestRuleResponse{
+		RuleId:    result.RuleId,
+		DeletedAt: timestamppb.New(result.DeletedAt),
+	}
+
+	return
+}
+
+// autoInvestRule converts an auto invest rule to its gRPC representation.
+func autoInvestRule(rule *model.AutoInvestRule) *gen.AutoInvestRule {
+	res := &gen.AutoInvestRule{
+		RuleId:         rule.Id,
+		Currency:       rule.Currency,
+		AmountPerLoan:  rule.AmountPerLoan.String(),
+		MinRoi:         rule.MinROI,
+		MaxPrincipal:   rule.MaxPrincipal.String(),
+		MaxTenorMonths: rule.MaxTenorMonths,
+		MaxRiskGrade:   string(rule.MaxRiskGrade),
+		CreatedAt:      timestamppb.New(rule.CreatedAt),
+	}
+	if rule.LastInvestedAt.Valid {
+		res.LastInvestedAt = timestamppb.New(rule.LastInvestedAt.Time)
+	}
+
+	return res
+}
@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/ffauzann/loan-service/proto/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetBorrowerCreditLimit returns the credit limit of a borrower and how much of it is used.
+// nolint
+func (s *srv) GetBorrowerCreditLimit(ctx context.Context, req *gen.GetBorrowerCreditLimitRequest) (res *gen.GetBorrowerCreditLimitResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.GetBorrowerCreditLimitRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesViewCreditLimit, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Begin core process for the request.
+	result, err := s.service.GetBorrowerCreditLimit(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.GetBorrowerCreditLimitResponse{
+		BorrowerId:      result.BorrowerId,
+		Currency:        result.Currency,
+		LimitAmount:     result.LimitAmount.String(),
+		IsDefault:       result.IsDefault,
+		ExposureAmount:  result.ExposureAmount.String(),
+		AvailableAmount: result.AvailableAmount.String(),
+	}
+	if !result.UpdatedAt.IsZero() {
+		res.UpdatedAt = timestamppb.New(result.UpdatedAt)
+	}
+
+	return
+}
+
+// SetBorrowerCreditLimit adjusts the credit limit of a borrower.
+// nolint
+func (s *srv) SetBorrowerCreditLimit(ctx context.Context, req *gen.SetBorrowerCreditLimitRequest) (res *gen.SetBorrowerCreditLimitResponse, err error) {
+	// Cast and validate request.
+	param, err := util.DecodeStruct[model.SetBorrowerCreditLimitRequest](req)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesManageCreditLimit, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set AdminId from claims.
+	param.AdminId = claims.UserId
+
+	// Begin core process for the request.
+	result, err := s.service.SetBorrowerCreditLimit(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.SetBorrowerCreditLimitResponse{
+		BorrowerId:      result.BorrowerId,
+		Currency:        result.Currency,
+		PreviousLimit:   result.PreviousLimit.String(),
+		LimitAmount:     result.LimitAmount.String(),
+		ExposureAmount:  result.ExposureAmount.String(),
+		AvailableAmount: result.AvailableAmount.String(),
+		UpdatedAt:       timestamppb.New(result.UpdatedAt),
+	}
+
+	return
+}
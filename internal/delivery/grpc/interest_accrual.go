@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/ffauzann/loan-service/proto/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetAccruedInterest handles the retrieval of the interest a loan accrued to date.
+// nolint
+func (s *srv) GetAccruedInterest(ctx context.Context, req *gen.GetAccruedInterestRequest) (res *gen.GetAccruedInterestResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.GetAccruedInterestRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesViewAccruedInterest, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set UserId and RoleId from claims.
+	param.UserId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.GetAccruedInterest(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.GetAccruedInterestResponse{
+		LoanId:             result.LoanId,
+		Currency:           result.Currency,
+		State:              result.State,
+		InterestRate:       result.InterestRate,
+		DayCountConvention: result.DayCountConvention,
+		AccruedAmount:      result.AccruedAmount.String(),
+		AccruedDays:        result.AccruedDays,
+	}
+	if !result.FirstAccrualDate.IsZero() {
+		res.FirstAccrualDate = timestamppb.New(result.FirstAccrualDate)
+	}
+	if !result.LastAccrualDate.IsZero() {
+		res.LastAccrualDate = timestamppb.New(result.LastAccrualDate)
+	}
+
+	return
+}
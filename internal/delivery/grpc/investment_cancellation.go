@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/ffauzann/loan-service/proto/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CancelInvestment handles the withdrawal of an investment within its cooling-off period.
+// nolint
+func (s *srv) CancelInvestment(ctx context.Context, req *gen.CancelInvestmentRequest) (res *gen.CancelInvestmentResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.CancelInvestmentRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesCancelInvestment, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set InvestorId and RoleId from claims.
+	param.InvestorId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.CancelInvestment(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.CancelInvestmentResponse{
+		InvestmentId:   result.InvestmentId,
+		LoanId:         result.LoanId,
+		Amount:         result.Amount.String(),
+		Currency:       result.Currency,
+		State:          result.State,
+		InvestedAmount: result.InvestedAmount.String(),
+		CancelledAt:    timestamppb.New(result.CancelledAt),
+	}
+
+	return
+}
@@ -15,7 +15,11 @@ import (
 // nolint
 func (s *srv) CreateLoan(ctx context.Context, req *gen.CreateLoanRequest) (res *gen.CreateLoanResponse, err error) {
 	// Cast and validate request.
-	param := util.CastStruct[model.CreateLoanRequest](req)
+	param, err := util.DecodeStruct[model.CreateLoanRequest](req)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
 	if err = util.ValidateStruct(param); err != nil {
 		util.LogContext(ctx).Error(err.Error())
 		return
@@ -47,9 +51,11 @@ func (s *srv) CreateLoan(ctx context.Context, req *gen.CreateLoanRequest) (res *
 
 	// Construct response.
 	res = &gen.CreateLoanResponse{
-		LoanId:    result.LoanId,
-		State:     result.State,
-		CreatedAt: timestamppb.New(result.CreatedAt),
+		LoanId:      result.LoanId,
+		Currency:    result.Currency,
+		State:       result.State,
+		CreatedAt:   timestamppb.New(result.CreatedAt),
+		TenorMonths: result.TenorMonths,
 	}
 
 	return
@@ -80,8 +86,9 @@ func (s *srv) ApproveLoan(ctx context.Context, req *gen.ApproveLoanRequest) (res
 		return
 	}
 
-	// Set ValidatorId from claims.
+	// Set ValidatorId and RoleId from claims.
 	param.ValidatorId = claims.UserId
+	param.RoleId = claims.RoleId
 
 	// Begin core process for the request.
 	result, err := s.service.ApproveLoan(ctx, param)
@@ -92,9 +99,61 @@ func (s *srv) ApproveLoan(ctx context.Context, req *gen.ApproveLoanRequest) (res
 
 	// Construct response.
 	res = &gen.ApproveLoanResponse{
-		LoanId:       result.LoanId,
-		State:        result.State,
-		ApprovalDate: timestamppb.New(result.ApprovalDate),
+		LoanId:        result.LoanId,
+		State:         result.State,
+		ApprovalDate:  timestamppb.New(result.ApprovalDate),
+		RiskGrade:     result.RiskGrade,
+		ApprovalStep:  result.ApprovalStep,
+		RequiredSteps: result.RequiredSteps,
+	}
+	if !result.FundingDeadline.IsZero() {
+		res.FundingDeadline = timestamppb.New(result.FundingDeadline)
+	}
+
+	return
+}
+
+// RejectLoan handles the rejection of a loan proposal.
+// nolint
+func (s *srv) RejectLoan(ctx context.Context, req *gen.RejectLoanRequest) (res *gen.RejectLoanResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.RejectLoanRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesApproveLoan, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set ValidatorId and RoleId from claims.
+	param.ValidatorId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.RejectLoan(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.RejectLoanResponse{
+		LoanId:        result.LoanId,
+		State:         result.State,
+		RejectionDate: timestamppb.New(result.RejectionDate),
 	}
 
 	return
@@ -104,7 +163,11 @@ func (s *srv) ApproveLoan(ctx context.Context, req *gen.ApproveLoanRequest) (res
 // nolint
 func (s *srv) InvestInLoan(ctx context.Context, req *gen.InvestInLoanRequest) (res *gen.InvestInLoanResponse, err error) {
 	// Cast and validate request.
-	param := util.CastStruct[model.InvestInLoanRequest](req)
+	param, err := util.DecodeStruct[model.InvestInLoanRequest](req)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
 	if err = util.ValidateStruct(param); err != nil {
 		util.LogContext(ctx).Error(err.Error())
 		return
@@ -125,8 +188,9 @@ func (s *srv) InvestInLoan(ctx context.Context, req *gen.InvestInLoanRequest) (r
 		return
 	}
 
-	// Set InvestorId from claims.
+	// Set InvestorId and RoleId from claims.
 	param.InvestorId = claims.UserId
+	param.RoleId = claims.RoleId
 
 	// Begin core process for the request.
 	result, err := s.service.InvestInLoan(ctx, param)
@@ -138,7 +202,8 @@ func (s *srv) InvestInLoan(ctx context.Context, req *gen.InvestInLoanRequest) (r
 	// Construct response.
 	res = &gen.InvestInLoanResponse{
 		LoanId:         result.LoanId,
-		InvestedAmount: result.InvestedAmount,
+		Currency:       result.Currency,
+		InvestedAmount: result.InvestedAmount.String(),
 		State:          result.State,
 	}
 
@@ -169,8 +234,9 @@ func (s *srv) DisburseLoan(ctx context.Context, req *gen.DisburseLoanRequest) (r
 		return
 	}
 
-	// Set OfficerId from claims.
+	// Set OfficerId and RoleId from claims.
 	param.OfficerId = claims.UserId
+	param.RoleId = claims.RoleId
 
 	// Begin core process for the request.
 	result, err := s.service.DisburseLoan(ctx, param)
@@ -188,3 +254,497 @@ func (s *srv) DisburseLoan(ctx context.Context, req *gen.DisburseLoanRequest) (r
 
 	return
 }
+
+// CancelLoan handles the cancellation of a loan by its borrower.
+// nolint
+func (s *srv) CancelLoan(ctx context.Context, req *gen.CancelLoanRequest) (res *gen.CancelLoanResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.CancelLoanRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesCancelLoan, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set BorrowerId and RoleId from claims.
+	param.BorrowerId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.CancelLoan(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.CancelLoanResponse{
+		LoanId:                  result.LoanId,
+		Currency:                result.Currency,
+		State:                   result.State,
+		ReversedAmount:          result.ReversedAmount.String(),
+		ReversedInvestmentCount: result.ReversedInvestmentCount,
+		CancellationDate:        timestamppb.New(result.CancellationDate),
+	}
+
+	return
+}
+
+// GetRepaymentSchedule returns the installments of a disbursed loan.
+// nolint
+func (s *srv) GetRepaymentSchedule(ctx context.Context, req *gen.GetRepaymentScheduleRequest) (res *gen.GetRepaymentScheduleResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.GetRepaymentScheduleRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesViewRepaymentSchedule, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set UserId and RoleId from claims.
+	param.UserId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.GetRepaymentSchedule(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.GetRepaymentScheduleResponse{
+		LoanId:         result.LoanId,
+		Currency:       result.Currency,
+		State:          result.State,
+		TenorMonths:    result.TenorMonths,
+		InterestRate:   result.InterestRate,
+		TotalPrincipal: result.TotalPrincipal.String(),
+		TotalInterest:  result.TotalInterest.String(),
+		Installments:   make([]*gen.Installment, 0, len(result.Installments)),
+	}
+	for _, installment := range result.Installments {
+		res.Installments = append(res.Installments, &gen.Installment{
+			InstallmentNumber: installment.InstallmentNumber,
+			DueDate:           timestamppb.New(installment.DueDate),
+			PrincipalAmount:   installment.PrincipalAmount.String(),
+			InterestAmount:    installment.InterestAmount.String(),
+			FeeAmount:         installment.FeeAmount.String(),
+			TotalAmount:       installment.TotalAmount.String(),
+			PaidAmount:        installment.PaidAmount.String(),
+			Status:            installment.Status,
+		})
+	}
+
+	return
+}
+
+// RecordRepayment applies a repayment to the schedule of a disbursed loan.
+// nolint
+func (s *srv) RecordRepayment(ctx context.Context, req *gen.RecordRepaymentRequest) (res *gen.RecordRepaymentResponse, err error) {
+	// Cast and validate request.
+	param, err := util.DecodeStruct[model.RecordRepaymentRequest](req)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesRecordRepayment, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set PayerId and RoleId from claims.
+	param.PayerId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.RecordRepayment(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.RecordRepaymentResponse{
+		LoanId:                  result.LoanId,
+		Currency:                result.Currency,
+		RepaymentId:             result.RepaymentId,
+		State:                   result.State,
+		AppliedFee:              result.AppliedFee.String(),
+		AppliedInterest:         result.AppliedInterest.String(),
+		AppliedPrincipal:        result.AppliedPrincipal.String(),
+		ExcessAmount:            result.ExcessAmount.String(),
+		OutstandingAmount:       result.OutstandingAmount.String(),
+		SettledInstallmentCount: result.SettledInstallmentCount,
+		PaymentDate:             timestamppb.New(result.PaymentDate),
+	}
+
+	return
+}
+
+// GetLoanDelinquency returns the days past due and overdue amounts of a loan.
+// nolint
+func (s *srv) GetLoanDelinquency(ctx context.Context, req *gen.GetLoanDelinquencyRequest) (res *gen.GetLoanDelinquencyResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.GetLoanDelinquencyRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesViewLoanDelinquency, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set UserId and RoleId from claims.
+	param.UserId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.GetLoanDelinquency(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.GetLoanDelinquencyResponse{
+		LoanId:              result.LoanId,
+		Currency:            result.Currency,
+		State:               result.State,
+		DaysPastDue:         result.DaysPastDue,
+		DelinquencyBucket:   result.DelinquencyBucket,
+		OverdueInstallments: result.OverdueInstallments,
+		OverdueAmount:       result.OverdueAmount.String(),
+		LateFeeAmount:       result.LateFeeAmount.String(),
+	}
+	if !result.OldestDueDate.IsZero() {
+		res.OldestDueDate = timestamppb.New(result.OldestDueDate)
+	}
+	if !result.DelinquencyCheckedAt.IsZero() {
+		res.DelinquencyCheckedAt = timestamppb.New(result.DelinquencyCheckedAt)
+	}
+
+	return
+}
+
+// WriteOffLoan writes off a non-performing loan and spreads the loss across its investors.
+// nolint
+func (s *srv) WriteOffLoan(ctx context.Context, req *gen.WriteOffLoanRequest) (res *gen.WriteOffLoanResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.WriteOffLoanRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesWriteOffLoan, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set AdminId and RoleId from claims.
+	param.AdminId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.WriteOffLoan(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.WriteOffLoanResponse{
+		LoanId:                result.LoanId,
+		Currency:              result.Currency,
+		State:                 result.State,
+		OutstandingPrincipal:  result.OutstandingPrincipal.String(),
+		OutstandingInterest:   result.OutstandingInterest.String(),
+		OutstandingFee:        result.OutstandingFee.String(),
+		LossAmount:            result.LossAmount.String(),
+		AffectedInvestorCount: result.AffectedInvestorCount,
+		WriteOffDate:          timestamppb.New(result.WriteOffDate),
+	}
+
+	return
+}
+
+// PrepayLoan pays a loan off early or prepays part of its principal.
+// nolint
+func (s *srv) PrepayLoan(ctx context.Context, req *gen.PrepayLoanRequest) (res *gen.PrepayLoanResponse, err error) {
+	// Cast and validate request.
+	param, err := util.DecodeStruct[model.PrepayLoanRequest](req)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesPrepayLoan, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set PayerId and RoleId from claims.
+	param.PayerId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.PrepayLoan(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.PrepayLoanResponse{
+		LoanId:                result.LoanId,
+		Currency:              result.Currency,
+		RepaymentId:           result.RepaymentId,
+		Type:                  result.Type,
+		Strategy:              result.Strategy,
+		State:                 result.State,
+		AppliedFee:            result.AppliedFee.String(),
+		AppliedInterest:       result.AppliedInterest.String(),
+		AppliedPrincipal:      result.AppliedPrincipal.String(),
+		ExcessAmount:          result.ExcessAmount.String(),
+		OutstandingPrincipal:  result.OutstandingPrincipal.String(),
+		TenorMonths:           result.TenorMonths,
+		NextInstallmentAmount: result.NextInstallmentAmount.String(),
+		PaymentDate:           timestamppb.New(result.PaymentDate),
+	}
+
+	return
+}
+
+// GetPayoffQuote returns the amount needed to pay a loan off as of a given date.
+// nolint
+func (s *srv) GetPayoffQuote(ctx context.Context, req *gen.GetPayoffQuoteRequest) (res *gen.GetPayoffQuoteResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.GetPayoffQuoteRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesViewPayoffQuote, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set UserId and RoleId from claims.
+	param.UserId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.GetPayoffQuote(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.GetPayoffQuoteResponse{
+		LoanId:               result.LoanId,
+		Currency:             result.Currency,
+		AsOf:                 timestamppb.New(result.AsOf),
+		OutstandingPrincipal: result.OutstandingPrincipal.String(),
+		AccruedInterest:      result.AccruedInterest.String(),
+		OutstandingFee:       result.OutstandingFee.String(),
+		PayoffAmount:         result.PayoffAmount.String(),
+	}
+
+	return
+}
+
+// RestructureLoan requests new terms for an active loan, pending review by another admin.
+// nolint
+func (s *srv) RestructureLoan(ctx context.Context, req *gen.RestructureLoanRequest) (res *gen.RestructureLoanResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.RestructureLoanRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesRestructureLoan, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set AdminId and RoleId from claims.
+	param.AdminId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.RestructureLoan(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.RestructureLoanResponse{
+		RestructureId:     result.RestructureId,
+		LoanId:            result.LoanId,
+		Status:            result.Status,
+		ExtendTenorMonths: result.ExtendTenorMonths,
+		GracePeriodMonths: result.GracePeriodMonths,
+		InterestRate:      result.InterestRate,
+		TenorMonths:       result.TenorMonths,
+		RequestedAt:       timestamppb.New(result.RequestedAt),
+	}
+
+	return
+}
+
+// ReviewLoanRestructure approves or rejects a pending loan restructure.
+// nolint
+func (s *srv) ReviewLoanRestructure(ctx context.Context, req *gen.ReviewLoanRestructureRequest) (res *gen.ReviewLoanRestructureResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.ReviewLoanRestructureRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesReviewLoanRestructure, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set ReviewerId and RoleId from claims.
+	param.ReviewerId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.ReviewLoanRestructure(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.ReviewLoanRestructureResponse{
+		RestructureId:     result.RestructureId,
+		LoanId:            result.LoanId,
+		Currency:          result.Currency,
+		Status:            result.Status,
+		LoanState:         result.LoanState,
+		InterestRate:      result.InterestRate,
+		TenorMonths:       result.TenorMonths,
+		CapitalizedAmount: result.CapitalizedAmount.String(),
+		InstallmentAmount: result.InstallmentAmount.String(),
+		ReviewedAt:        timestamppb.New(result.ReviewedAt),
+	}
+
+	return
+}
@@ -0,0 +1,134 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/ffauzann/loan-service/proto/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetLoan handles the retrieval of a single loan.
+// nolint
+func (s *srv) GetLoan(ctx context.Context, req *gen.GetLoanRequest) (res *gen.GetLoanResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.GetLoanRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesViewLoan, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set UserId and RoleId from claims.
+	param.UserId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.GetLoan(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.GetLoanResponse{
+		Loan: loan(result.Loan),
+	}
+
+	return
+}
+
+// ListLoans handles the filtered, paginated listing of the loans visible to the requester.
+// nolint
+func (s *srv) ListLoans(ctx context.Context, req *gen.ListLoansRequest) (res *gen.ListLoansResponse, err error) {
+	// Cast and validate request.
+	param, err := util.DecodeStruct[model.ListLoansRequest](req)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesViewLoan, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set UserId and RoleId from claims.
+	param.UserId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.ListLoans(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.ListLoansResponse{
+		Loans:      make([]*gen.Loan, 0, len(result.Loans)),
+		NextCursor: result.NextCursor,
+	}
+	for _, l := range result.Loans {
+		res.Loans = append(res.Loans, loan(l))
+	}
+
+	return
+}
+
+func loan(l *model.Loan) *gen.Loan {
+	res := &gen.Loan{
+		Id:                l.Id,
+		BorrowerId:        l.BorrowerId,
+		PrincipalAmount:   l.PrincipalAmount.String(),
+		Currency:          l.Currency,
+		InterestRate:      l.InterestRate,
+		Roi:               l.ROI,
+		State:             string(l.State),
+		InvestedAmount:    l.InvestedAmount.String(),
+		TenorMonths:       l.TenorMonths,
+		RiskGrade:         string(l.RiskGrade),
+		DaysPastDue:       l.DaysPastDue,
+		DelinquencyBucket: string(l.DelinquencyBucket),
+		CreatedAt:         timestamppb.New(l.CreatedAt),
+	}
+	if l.AgreementLink != nil {
+		res.AgreementLink = *l.AgreementLink
+	}
+	if l.FundingDeadline.Valid {
+		res.FundingDeadline = timestamppb.New(l.FundingDeadline.Time)
+	}
+
+	return res
+}
@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/ffauzann/loan-service/proto/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetPortfolio handles the retrieval of the holdings and returns of an investor.
+// nolint
+func (s *srv) GetPortfolio(ctx context.Context, req *gen.GetPortfolioRequest) (res *gen.GetPortfolioResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.GetPortfolioRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesViewPortfolio, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set InvestorId from claims.
+	param.InvestorId = claims.UserId
+
+	// Begin core process for the request.
+	result, err := s.service.GetPortfolio(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.GetPortfolioResponse{
+		Totals:     make([]*gen.PortfolioTotal, 0, len(result.Totals)),
+		Holdings:   make([]*gen.PortfolioHolding, 0, len(result.Holdings)),
+		NextCursor: result.NextCursor,
+	}
+	for _, total := range result.Totals {
+		res.Totals = append(res.Totals, portfolioTotal(total))
+	}
+	for _, holding := range result.Holdings {
+		res.Holdings = append(res.Holdings, portfolioHolding(holding))
+	}
+
+	return
+}
+
+func portfolioTotal(total *model.PortfolioTotal) *gen.PortfolioTotal {
+	res := &gen.PortfolioTotal{
+		Currency:             total.Currency,
+		Positions:            total.Positions,
+		InvestedAmount:       total.InvestedAmount.String(),
+		ExpectedReturn:       total.ExpectedReturn.String(),
+		ReceivedPrincipal:    total.ReceivedPrincipal.String(),
+		ReceivedReturn:       total.ReceivedReturn.String(),
+		OutstandingPrincipal: total.OutstandingPrincipal.String(),
+		States:               make([]*gen.PortfolioStateSummary, 0, len(total.States)),
+	}
+	for _, state := range total.States {
+		res.States = append(res.States, &gen.PortfolioStateSummary{
+			LoanState:            string(state.LoanState),
+			Positions:            state.Positions,
+			InvestedAmount:       state.InvestedAmount.String(),
+			ExpectedReturn:       state.ExpectedReturn.String(),
+			ReceivedPrincipal:    state.ReceivedPrincipal.String(),
+			ReceivedReturn:       state.ReceivedReturn.String(),
+			OutstandingPrincipal: state.OutstandingPrincipal.String(),
+		})
+	}
+
+	return res
+}
+
+func portfolioHolding(holding *model.PortfolioHolding) *gen.PortfolioHolding {
+	return &gen.PortfolioHolding{
+		InvestmentId:         holding.InvestmentId,
+		LoanId:               holding.LoanId,
+		LoanState:            string(holding.LoanState),
+		Currency:             holding.Currency,
+		InvestedAmount:       holding.InvestedAmount.String(),
+		InvestedAt:           timestamppb.New(holding.InvestedAt),
+		OwnershipPercentage:  holding.OwnershipPercentage,
+		Roi:                  holding.ROI,
+		ExpectedReturn:       holding.ExpectedReturn.String(),
+		ReceivedPrincipal:    holding.ReceivedPrincipal.String(),
+		ReceivedReturn:       holding.ReceivedReturn.String(),
+		OutstandingPrincipal: holding.OutstandingPrincipal.String(),
+	}
+}
@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/ffauzann/loan-service/proto/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ReserveInvestment handles holding part of a loan for an investor until the investment is confirmed.
+// nolint
+func (s *srv) ReserveInvestment(ctx context.Context, req *gen.ReserveInvestmentRequest) (res *gen.ReserveInvestmentResponse, err error) {
+	// Cast and validate request.
+	param, err := util.DecodeStruct[model.ReserveInvestmentRequest](req)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesInvestLoan, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set InvestorId and RoleId from claims.
+	param.InvestorId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.ReserveInvestment(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.ReserveInvestmentResponse{
+		ReservationId:   result.ReservationId,
+		LoanId:          result.LoanId,
+		Amount:          result.Amount.String(),
+		Currency:        result.Currency,
+		ExpiresAt:       timestamppb.New(result.ExpiresAt),
+		AvailableAmount: result.AvailableAmount.String(),
+	}
+
+	return
+}
+
+// ConfirmInvestment handles turning a held reservation into an investment.
+// nolint
+func (s *srv) ConfirmInvestment(ctx context.Context, req *gen.ConfirmInvestmentRequest) (res *gen.ConfirmInvestmentResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.ConfirmInvestmentRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesInvestLoan, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set InvestorId and RoleId from claims.
+	param.InvestorId = claims.UserId
+	param.RoleId = claims.RoleId
+
+	// Begin core process for the request.
+	result, err := s.service.ConfirmInvestment(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.ConfirmInvestmentResponse{
+		ReservationId:  result.ReservationId,
+		InvestmentId:   result.InvestmentId,
+		LoanId:         result.LoanId,
+		InvestedAmount: result.InvestedAmount.String(),
+		Currency:       result.Currency,
+		State:          result.State,
+	}
+
+	return
+}
@@ -0,0 +1,273 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/ffauzann/loan-service/proto/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateInvestmentListing handles the listing of an investment position on the secondary market.
+// nolint
+func (s *srv) CreateInvestmentListing(ctx context.Context, req *gen.CreateInvestmentListingRequest) (res *gen.CreateInvestmentListingResponse, err error) {
+	// Cast and validate request.
+	param, err := util.DecodeStruct[model.CreateInvestmentListingRequest](req)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesTradeInvestment, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set SellerId from claims.
+	param.SellerId = claims.UserId
+
+	// Begin core process for the request.
+	result, err := s.service.CreateInvestmentListing(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.CreateInvestmentListingResponse{
+		Listing: investmentListing(result.Listing),
+	}
+
+	return
+}
+
+// CancelInvestmentListing handles the withdrawal of a listing from the secondary market.
+// nolint
+func (s *srv) CancelInvestmentListing(ctx context.Context, req *gen.CancelInvestmentListingRequest) (res *gen.CancelInvestmentListingResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.CancelInvestmentListingRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesTradeInvestment, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set SellerId from claims.
+	param.SellerId = claims.UserId
+
+	// Begin core process for the request.
+	result, err := s.service.CancelInvestmentListing(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.CancelInvestmentListingResponse{
+		Listing: investmentListing(result.Listing),
+	}
+
+	return
+}
+
+// BuyInvestmentListing handles the purchase of a listed position on the secondary market.
+// nolint
+func (s *srv) BuyInvestmentListing(ctx context.Context, req *gen.BuyInvestmentListingRequest) (res *gen.BuyInvestmentListingResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.BuyInvestmentListingRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesTradeInvestment, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set BuyerId from claims.
+	param.BuyerId = claims.UserId
+
+	// Begin core process for the request.
+	result, err := s.service.BuyInvestmentListing(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.BuyInvestmentListingResponse{
+		Transfer: investmentTransfer(result.Transfer),
+	}
+
+	return
+}
+
+// ListInvestmentListings handles the listing of the secondary market offers.
+// nolint
+func (s *srv) ListInvestmentListings(ctx context.Context, req *gen.ListInvestmentListingsRequest) (res *gen.ListInvestmentListingsResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.ListInvestmentListingsRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesViewSecondaryMarket, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Begin core process for the request.
+	result, err := s.service.ListInvestmentListings(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.ListInvestmentListingsResponse{
+		Listings: make([]*gen.InvestmentListing, 0, len(result.Listings)),
+	}
+	for _, listing := range result.Listings {
+		res.Listings = append(res.Listings, investmentListing(listing))
+	}
+
+	return
+}
+
+// ListInvestmentTransfers handles the listing of the secondary market transfer history.
+// Investors only see the transfers they took part in.
+// nolint
+func (s *srv) ListInvestmentTransfers(ctx context.Context, req *gen.ListInvestmentTransfersRequest) (res *gen.ListInvestmentTransfersResponse, err error) {
+	// Cast and validate request.
+	param := util.CastStruct[model.ListInvestmentTransfersRequest](req)
+	if err = util.ValidateStruct(param); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Extract claims from context.
+	claims, ok := util.ClaimsFromContext(ctx)
+	if !ok {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate role_id from claims.
+	if !slices.Contains(constant.AllowedRolesViewSecondaryMarket, claims.RoleId) {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Set InvestorId from claims.
+	if claims.RoleId == constant.RoleIdInvestor {
+		param.InvestorId = claims.UserId
+	}
+
+	// Begin core process for the request.
+	result, err := s.service.ListInvestmentTransfers(ctx, param)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &gen.ListInvestmentTransfersResponse{
+		Transfers: make([]*gen.InvestmentTransfer, 0, len(result.Transfers)),
+	}
+	for _, transfer := range result.Transfers {
+		res.Transfers = append(res.Transfers, investmentTransfer(transfer))
+	}
+
+	return
+}
+
+func investmentListing(listing *model.InvestmentListing) *gen.InvestmentListing {
+	res := &gen.InvestmentListing{
+		ListingId:    listing.Id,
+		InvestmentId: listing.InvestmentId,
+		LoanId:       listing.LoanId,
+		SellerId:     listing.SellerId,
+		Amount:       listing.Amount.String(),
+		Price:        listing.Price.String(),
+		Currency:     listing.Currency,
+		Status:       string(listing.Status),
+		CreatedAt:    timestamppb.New(listing.CreatedAt),
+	}
+	if listing.ClosedAt.Valid {
+		res.ClosedAt = timestamppb.New(listing.ClosedAt.Time)
+	}
+
+	return res
+}
+
+func investmentTransfer(transfer *model.InvestmentTransfer) *gen.InvestmentTransfer {
+	return &gen.InvestmentTransfer{
+		TransferId:            transfer.Id,
+		ListingId:             transfer.ListingId,
+		LoanId:                transfer.LoanId,
+		SellerId:              transfer.SellerId,
+		BuyerId:               transfer.BuyerId,
+		SourceInvestmentId:    transfer.SourceInvestmentId,
+		BuyerInvestmentId:     transfer.BuyerInvestmentId,
+		RemainderInvestmentId: uint64(transfer.RemainderInvestmentId.Int64),
+		Amount:                transfer.Amount.String(),
+		Price:                 transfer.Price.String(),
+		Currency:              transfer.Currency,
+		TransferredAt:         timestamppb.New(transfer.TransferredAt),
+	}
+}
@@ -34,6 +34,12 @@ func New(consumer *kafka.Reader, svc service.Service) {
 					util.Log().Error(err.Error())
 				}
 			}()
+		case constant.TopicLoanCancelled, constant.TopicLoanExpired, constant.TopicLoanDelinquent, constant.TopicLoanRestructured:
+			go func() {
+				if err := s.Notification(ctx, msg.Value); err != nil {
+					util.Log().Error(err.Error())
+				}
+			}()
 		default:
 			util.Log().Warn("unhandled kafka topic", zap.String("topic", msg.Topic))
 		}
@@ -0,0 +1,25 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/ffauzann/loan-service/internal/util"
+	"go.uber.org/zap"
+)
+
+// AccrueInterest posts the daily interest accruals of disbursed loans, backfilling missed days.
+func (s *srv) AccrueInterest(ctx context.Context) (err error) {
+	res, err := s.service.AccrueInterest(ctx)
+	if err != nil {
+		return
+	}
+
+	if res.PostedAccrualCount > 0 {
+		util.Log().Info("interest accrued",
+			zap.Uint32("accrued_loan_count", res.AccruedLoanCount),
+			zap.Uint32("posted_accrual_count", res.PostedAccrualCount),
+		)
+	}
+
+	return
+}
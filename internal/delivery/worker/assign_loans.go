@@ -0,0 +1,22 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/ffauzann/loan-service/internal/util"
+	"go.uber.org/zap"
+)
+
+// AssignLoans assigns the proposed loans left in the queue to field validators.
+func (s *srv) AssignLoans(ctx context.Context) (err error) {
+	res, err := s.service.AssignLoans(ctx)
+	if err != nil {
+		return
+	}
+
+	if res.AssignedLoanCount > 0 {
+		util.Log().Info("loans assigned", zap.Uint32("assigned_loan_count", res.AssignedLoanCount))
+	}
+
+	return
+}
@@ -0,0 +1,22 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/ffauzann/loan-service/internal/util"
+	"go.uber.org/zap"
+)
+
+// ExpireLoans expires approved/funding loans whose funding deadline has passed.
+func (s *srv) ExpireLoans(ctx context.Context) (err error) {
+	res, err := s.service.ExpireLoans(ctx)
+	if err != nil {
+		return
+	}
+
+	if len(res.ExpiredLoanIds) > 0 {
+		util.Log().Info("loans expired", zap.Uint64s("loan_ids", res.ExpiredLoanIds), zap.Any("refunded_amounts", res.RefundedAmounts))
+	}
+
+	return
+}
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/service"
+	"github.com/ffauzann/loan-service/internal/util"
+	"go.uber.org/zap"
+)
+
+type srv struct {
+	service service.Service
+}
+
+type job struct {
+	name     string
+	interval string
+	run      func(ctx context.Context) error
+}
+
+// New starts every configured background job and blocks until all of them stop.
+// Jobs with an empty or malformed interval are disabled.
+func New(svc service.Service, config *model.WorkerConfig) {
+	s := &srv{
+		service: svc,
+	}
+
+	jobs := []job{
+		{name: "expire-loans", interval: config.LoanExpiryInterval, run: s.ExpireLoans},
+		{name: "track-delinquency", interval: config.DelinquencyInterval, run: s.TrackDelinquency},
+		{name: "release-reservations", interval: config.ReservationExpiryInterval, run: s.ReleaseReservations},
+		{name: "accrue-interest", interval: config.InterestAccrualInterval, run: s.AccrueInterest},
+		{name: "assign-loans", interval: config.LoanAssignmentInterval, run: s.AssignLoans},
+	}
+
+	var wg sync.WaitGroup
+	for _, j := range jobs {
+		interval, err := time.ParseDuration(j.interval)
+		if err != nil || interval <= 0 {
+			util.Log().Warn("background job is disabled", zap.String("job", j.name))
+			continue
+		}
+
+		wg.Add(1)
+		go func(j job, interval time.Duration) {
+			defer wg.Done()
+			s.schedule(j, interval)
+		}(j, interval)
+	}
+	wg.Wait()
+}
+
+// schedule runs the job once every interval, starting right away.
+func (s *srv) schedule(j job, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.run(context.Background()); err != nil {
+			util.Log().Error("background job failed", zap.String("job", j.name), zap.Error(err))
+		}
+		<-ticker.C
+	}
+}
@@ -0,0 +1,22 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/ffauzann/loan-service/internal/util"
+	"go.uber.org/zap"
+)
+
+// ReleaseReservations releases investment reservations that were not confirmed before their expiry.
+func (s *srv) ReleaseReservations(ctx context.Context) (err error) {
+	res, err := s.service.ReleaseExpiredReservations(ctx)
+	if err != nil {
+		return
+	}
+
+	if len(res.ReleasedReservationIds) > 0 {
+		util.Log().Info("investment reservations released", zap.Uint64s("reservation_ids", res.ReleasedReservationIds))
+	}
+
+	return
+}
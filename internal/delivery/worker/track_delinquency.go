@@ -0,0 +1,26 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/ffauzann/loan-service/internal/util"
+	"go.uber.org/zap"
+)
+
+// TrackDelinquency refreshes days past due of repaying loans and charges late fees.
+func (s *srv) TrackDelinquency(ctx context.Context) (err error) {
+	res, err := s.service.TrackDelinquency(ctx)
+	if err != nil {
+		return
+	}
+
+	if res.CheckedLoanCount > 0 || res.ChargedLateFeeCount > 0 {
+		util.Log().Info("delinquency tracked",
+			zap.Uint32("checked_loan_count", res.CheckedLoanCount),
+			zap.Uint64s("delinquent_loan_ids", res.DelinquentLoanIds),
+			zap.Uint32("charged_late_fee_count", res.ChargedLateFeeCount),
+		)
+	}
+
+	return
+}
@@ -0,0 +1,4 @@
+-- 1. Child tables of loan
+DROP TABLE IF EXISTS loan_installment_history;
+DROP TABLE IF EXISTS loan_restructure;
+DROP TYPE IF EXISTS loan_restructure_status;
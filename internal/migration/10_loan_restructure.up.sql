@@ -0,0 +1,48 @@
+-- Loan restructure table.
+-- This table records restructure requests raised by an admin and reviewed by another one.
+-- Only one restructure can be pending per loan at a time.
+CREATE TYPE loan_restructure_status AS ENUM ('PENDING', 'APPROVED', 'REJECTED');
+CREATE TABLE IF NOT EXISTS loan_restructure (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    loan_id BIGINT NOT NULL REFERENCES loan(id),
+    status loan_restructure_status NOT NULL DEFAULT 'PENDING',
+    extend_tenor_months INT NOT NULL DEFAULT 0,
+    grace_period_months INT NOT NULL DEFAULT 0,
+    previous_interest_rate NUMERIC(5,2) NOT NULL,
+    new_interest_rate NUMERIC(5,2) NOT NULL,
+    previous_tenor_months INT NOT NULL,
+    new_tenor_months INT NOT NULL,
+    capitalized_amount NUMERIC(15,2) NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL DEFAULT '',
+    requested_by BIGINT NOT NULL REFERENCES "user"(id),
+    reviewed_by BIGINT REFERENCES "user"(id),
+    review_note TEXT NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by BIGINT
+);
+
+CREATE UNIQUE INDEX loan_restructure_pending_idx ON loan_restructure (loan_id) WHERE status = 'PENDING';
+
+-- Loan installment history table.
+-- This table keeps a snapshot of the whole schedule as it was right before a restructure replaced it.
+CREATE TABLE IF NOT EXISTS loan_installment_history (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    restructure_id BIGINT NOT NULL REFERENCES loan_restructure(id),
+    loan_id BIGINT NOT NULL REFERENCES loan(id),
+    installment_number INT NOT NULL,
+    due_date DATE NOT NULL,
+    principal_amount NUMERIC(15,2) NOT NULL,
+    interest_amount NUMERIC(15,2) NOT NULL,
+    fee_amount NUMERIC(15,2) NOT NULL,
+    paid_fee NUMERIC(15,2) NOT NULL,
+    paid_interest NUMERIC(15,2) NOT NULL,
+    paid_principal NUMERIC(15,2) NOT NULL,
+    paid_at TIMESTAMPTZ,
+    status loan_installment_status NOT NULL,
+    archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    UNIQUE (restructure_id, installment_number)
+);
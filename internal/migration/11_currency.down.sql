@@ -0,0 +1,5 @@
+-- 1. Loan investment currency
+ALTER TABLE loan_investment DROP COLUMN IF EXISTS currency;
+
+-- 2. Loan currency
+ALTER TABLE loan DROP COLUMN IF EXISTS currency;
@@ -0,0 +1,7 @@
+-- Loan currency.
+-- ISO 4217 code of every amount of the loan, existing loans were implicitly IDR.
+ALTER TABLE loan ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'IDR';
+
+-- Loan investment currency.
+-- Always the currency of the invested loan.
+ALTER TABLE loan_investment ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'IDR';
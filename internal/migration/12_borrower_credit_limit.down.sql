@@ -0,0 +1,6 @@
+-- 1. Loan borrower index
+DROP INDEX IF EXISTS loan_borrower_idx;
+
+-- 2. Borrower credit limit tables
+DROP TABLE IF EXISTS borrower_credit_limit_audit;
+DROP TABLE IF EXISTS borrower_credit_limit;
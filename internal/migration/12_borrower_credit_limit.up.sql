@@ -0,0 +1,35 @@
+-- Borrower credit limit table.
+-- This table records the credit limit of a borrower per currency, borrowers without one use the configured default.
+-- The principal of every open loan of the borrower counts against the limit.
+CREATE TABLE IF NOT EXISTS borrower_credit_limit (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    borrower_id BIGINT NOT NULL REFERENCES "user"(id),
+    currency CHAR(3) NOT NULL,
+    limit_amount NUMERIC(15,2) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by BIGINT,
+
+    UNIQUE (borrower_id, currency)
+);
+
+-- Borrower credit limit audit table.
+-- This table records every change of a borrower credit limit, previous_limit is NULL when the default applied before.
+CREATE TABLE IF NOT EXISTS borrower_credit_limit_audit (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    borrower_id BIGINT NOT NULL REFERENCES "user"(id),
+    currency CHAR(3) NOT NULL,
+    previous_limit NUMERIC(15,2),
+    new_limit NUMERIC(15,2) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    changed_by BIGINT NOT NULL REFERENCES "user"(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT
+);
+
+CREATE INDEX borrower_credit_limit_audit_borrower_idx ON borrower_credit_limit_audit (borrower_id, currency);
+
+-- Loan borrower index.
+-- Used to sum the exposure of a borrower.
+CREATE INDEX IF NOT EXISTS loan_borrower_idx ON loan (borrower_id);
@@ -0,0 +1,2 @@
+-- 1. Loan investment investor index
+DROP INDEX IF EXISTS loan_investment_investor_idx;
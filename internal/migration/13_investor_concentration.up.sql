@@ -0,0 +1,3 @@
+-- Loan investment investor index.
+-- Used to sum the holding of an investor in a loan and its exposure to a borrower.
+CREATE INDEX IF NOT EXISTS loan_investment_investor_idx ON loan_investment (investor_id, loan_id) WHERE status = 'ACTIVE';
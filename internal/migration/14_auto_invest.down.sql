@@ -0,0 +1,5 @@
+-- 1. Auto invest rule table
+DROP TABLE IF EXISTS auto_invest_rule;
+
+-- 2. Loan risk grade
+ALTER TABLE loan DROP COLUMN IF EXISTS risk_grade;
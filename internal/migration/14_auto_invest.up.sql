@@ -0,0 +1,28 @@
+-- Loan risk grade.
+-- Assigned by the field validator on approval, from A (lowest risk) to E. Empty for loans approved without a grade.
+ALTER TABLE loan ADD COLUMN IF NOT EXISTS risk_grade VARCHAR(1) NOT NULL DEFAULT '';
+
+-- Auto invest rule table.
+-- This table records the rules investors save to invest automatically in newly approved loans.
+-- A zero max_principal or max_tenor_months and an empty max_risk_grade match any loan, deleted rules are kept for auditing.
+-- last_invested_at rotates matching rules so every investor gets its turn at a loan.
+CREATE TABLE IF NOT EXISTS auto_invest_rule (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    investor_id BIGINT NOT NULL REFERENCES "user"(id),
+    currency CHAR(3) NOT NULL,
+    amount_per_loan NUMERIC(15,2) NOT NULL,
+    min_roi NUMERIC(5,2) NOT NULL DEFAULT 0,
+    max_principal NUMERIC(15,2) NOT NULL DEFAULT 0,
+    max_tenor_months INT NOT NULL DEFAULT 0,
+    max_risk_grade VARCHAR(1) NOT NULL DEFAULT '',
+    last_invested_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by BIGINT,
+    deleted_at TIMESTAMPTZ,
+    deleted_by BIGINT
+);
+
+CREATE INDEX IF NOT EXISTS auto_invest_rule_investor_idx ON auto_invest_rule (investor_id);
+CREATE INDEX IF NOT EXISTS auto_invest_rule_active_idx ON auto_invest_rule (currency, last_invested_at) WHERE deleted_at IS NULL;
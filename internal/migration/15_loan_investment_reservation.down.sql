@@ -0,0 +1,3 @@
+-- 1. Loan investment reservation table
+DROP TABLE IF EXISTS loan_investment_reservation;
+DROP TYPE IF EXISTS loan_investment_reservation_status;
@@ -0,0 +1,22 @@
+-- Loan investment reservation table.
+-- This table records the holds investors place on part of the remaining capacity of a loan before confirming the investment.
+-- A HELD reservation counts toward the capacity of the loan until it expires, CONFIRMED ones point to the investment they became.
+CREATE TYPE loan_investment_reservation_status AS ENUM ('HELD', 'CONFIRMED', 'EXPIRED');
+CREATE TABLE IF NOT EXISTS loan_investment_reservation (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    loan_id BIGINT NOT NULL REFERENCES loan(id),
+    investor_id BIGINT NOT NULL REFERENCES "user"(id),
+    amount NUMERIC(15,2) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    status loan_investment_reservation_status NOT NULL DEFAULT 'HELD',
+    expires_at TIMESTAMPTZ NOT NULL,
+    investment_id BIGINT REFERENCES loan_investment(id),
+    confirmed_at TIMESTAMPTZ,
+    released_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by BIGINT
+);
+
+CREATE INDEX IF NOT EXISTS loan_investment_reservation_held_idx ON loan_investment_reservation (loan_id, expires_at) WHERE status = 'HELD';
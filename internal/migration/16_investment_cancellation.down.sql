@@ -0,0 +1 @@
+-- 1. PostgreSQL cannot drop a single enum value, so 'CANCELLED' stays in loan_investment_status.
@@ -0,0 +1,4 @@
+-- Loan investment status.
+-- Add CANCELLED status for investments withdrawn by their investor within the cooling-off window.
+-- reversed_at records when the investment was cancelled.
+ALTER TYPE loan_investment_status ADD VALUE IF NOT EXISTS 'CANCELLED';
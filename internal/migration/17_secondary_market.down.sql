@@ -0,0 +1,6 @@
+-- 1. Secondary market tables
+DROP TABLE IF EXISTS investment_transfer;
+DROP TABLE IF EXISTS investment_listing;
+DROP TYPE IF EXISTS investment_listing_status;
+
+-- 2. PostgreSQL cannot drop a single enum value, so 'TRANSFERRED' stays in loan_investment_status.
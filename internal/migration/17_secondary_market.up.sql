@@ -0,0 +1,50 @@
+-- Loan investment status.
+-- Add TRANSFERRED status for positions sold on the secondary market.
+-- The sold part and the unsold remainder continue as new ACTIVE investments, so payouts follow the current holders.
+ALTER TYPE loan_investment_status ADD VALUE IF NOT EXISTS 'TRANSFERRED';
+
+-- Investment listing table.
+-- This table records the positions investors offer on the secondary market, all or part of an investment at a price.
+-- An investment can only have one open listing at a time.
+CREATE TYPE investment_listing_status AS ENUM ('OPEN', 'SOLD', 'CANCELLED');
+CREATE TABLE IF NOT EXISTS investment_listing (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    investment_id BIGINT NOT NULL REFERENCES loan_investment(id),
+    loan_id BIGINT NOT NULL REFERENCES loan(id),
+    seller_id BIGINT NOT NULL REFERENCES "user"(id),
+    amount NUMERIC(15,2) NOT NULL, -- Principal of the position offered.
+    price NUMERIC(15,2) NOT NULL, -- Price asked for the position.
+    currency CHAR(3) NOT NULL,
+    status investment_listing_status NOT NULL DEFAULT 'OPEN',
+    closed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by BIGINT
+);
+
+CREATE UNIQUE INDEX investment_listing_open_idx ON investment_listing (investment_id) WHERE status = 'OPEN';
+CREATE INDEX IF NOT EXISTS investment_listing_loan_idx ON investment_listing (loan_id, status);
+
+-- Investment transfer table.
+-- This table records every sale on the secondary market and the investments it closed and opened.
+CREATE TABLE IF NOT EXISTS investment_transfer (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    listing_id BIGINT NOT NULL REFERENCES investment_listing(id),
+    loan_id BIGINT NOT NULL REFERENCES loan(id),
+    seller_id BIGINT NOT NULL REFERENCES "user"(id),
+    buyer_id BIGINT NOT NULL REFERENCES "user"(id),
+    source_investment_id BIGINT NOT NULL REFERENCES loan_investment(id),
+    buyer_investment_id BIGINT NOT NULL REFERENCES loan_investment(id),
+    remainder_investment_id BIGINT REFERENCES loan_investment(id), -- NULL when the whole position was sold.
+    amount NUMERIC(15,2) NOT NULL,
+    price NUMERIC(15,2) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    transferred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT
+);
+
+CREATE INDEX IF NOT EXISTS investment_transfer_loan_idx ON investment_transfer (loan_id);
+CREATE INDEX IF NOT EXISTS investment_transfer_seller_idx ON investment_transfer (seller_id);
+CREATE INDEX IF NOT EXISTS investment_transfer_buyer_idx ON investment_transfer (buyer_id);
@@ -0,0 +1,2 @@
+-- 1. Loan interest accrual table
+DROP TABLE IF EXISTS loan_interest_accrual;
@@ -0,0 +1,16 @@
+-- Loan interest accrual table.
+-- This table records the interest a disbursed loan accrues every calendar day, for accounting.
+-- A loan accrues at most once per date, so the accrual job can safely rerun and backfill missed days.
+CREATE TABLE IF NOT EXISTS loan_interest_accrual (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    loan_id BIGINT NOT NULL REFERENCES loan(id),
+    accrual_date DATE NOT NULL,
+    principal_amount NUMERIC(15,2) NOT NULL, -- Outstanding principal interest accrued on.
+    interest_rate NUMERIC(5,2) NOT NULL, -- Annual interest rate in percent.
+    day_count_convention VARCHAR(10) NOT NULL, -- ACT/365 or 30/360.
+    amount NUMERIC(15,2) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT,
+    UNIQUE (loan_id, accrual_date)
+);
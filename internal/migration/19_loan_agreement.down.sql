@@ -0,0 +1,3 @@
+-- 1. Loan agreement table
+DROP TABLE IF EXISTS loan_agreement;
+DROP TYPE IF EXISTS loan_agreement_type;
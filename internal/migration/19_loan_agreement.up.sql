@@ -0,0 +1,16 @@
+-- Loan agreement table.
+-- This table records the agreement documents generated once a loan is fully invested:
+-- one borrower agreement per loan and one lender agreement per investor, each rendered from a versioned template.
+CREATE TYPE loan_agreement_type AS ENUM ('BORROWER', 'LENDER');
+CREATE TABLE IF NOT EXISTS loan_agreement (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    loan_id BIGINT NOT NULL REFERENCES loan(id),
+    type loan_agreement_type NOT NULL,
+    investor_id BIGINT REFERENCES "user"(id), -- NULL for the borrower agreement.
+    template_version VARCHAR(20) NOT NULL,
+    document_link TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT
+);
+
+CREATE UNIQUE INDEX loan_agreement_party_idx ON loan_agreement (loan_id, type, COALESCE(investor_id, 0));
@@ -0,0 +1,5 @@
+-- 1. Investor payout investment index
+DROP INDEX IF EXISTS investor_payout_investment_idx;
+
+-- 2. Loan investment portfolio index
+DROP INDEX IF EXISTS loan_investment_portfolio_idx;
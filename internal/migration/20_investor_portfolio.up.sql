@@ -0,0 +1,7 @@
+-- Loan investment portfolio index.
+-- Used to page through the active investments of an investor, newest first.
+CREATE INDEX IF NOT EXISTS loan_investment_portfolio_idx ON loan_investment (investor_id, id) WHERE status = 'ACTIVE';
+
+-- Investor payout investment index.
+-- Used to sum the payouts received by every investment of a portfolio.
+CREATE INDEX IF NOT EXISTS investor_payout_investment_idx ON investor_payout (investment_id);
@@ -0,0 +1,11 @@
+-- 1. Loan approval steps
+DELETE FROM loan_approval WHERE step > 1;
+
+ALTER TABLE loan_approval
+    DROP CONSTRAINT IF EXISTS loan_approval_approver_key,
+    DROP CONSTRAINT IF EXISTS loan_approval_step_key,
+    ALTER COLUMN photo_proof_link SET NOT NULL,
+    DROP COLUMN IF EXISTS role_id,
+    DROP COLUMN IF EXISTS required_steps,
+    DROP COLUMN IF EXISTS step,
+    ADD CONSTRAINT loan_approval_loan_id_key UNIQUE (loan_id);
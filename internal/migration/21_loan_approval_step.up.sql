@@ -0,0 +1,19 @@
+-- Loan approval steps.
+-- Large loans may go through several approval steps, one row per step, each done by a different user.
+-- role_id is the role the approver acted with and is backfilled from the approver for existing approvals.
+-- photo_proof_link is only provided on the first step, later steps sign off the terms it set.
+ALTER TABLE loan_approval
+    DROP CONSTRAINT IF EXISTS loan_approval_loan_id_key,
+    ADD COLUMN IF NOT EXISTS step SMALLINT NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS required_steps SMALLINT NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS role_id BIGINT REFERENCES "role"(id),
+    ALTER COLUMN photo_proof_link DROP NOT NULL,
+    ADD CONSTRAINT loan_approval_step_key UNIQUE (loan_id, step),
+    ADD CONSTRAINT loan_approval_approver_key UNIQUE (loan_id, validator_id);
+
+UPDATE loan_approval la
+SET role_id = u.role_id
+FROM "user" u
+WHERE u.id = la.validator_id AND la.role_id IS NULL;
+
+ALTER TABLE loan_approval ALTER COLUMN role_id SET NOT NULL;
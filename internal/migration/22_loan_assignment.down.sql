@@ -0,0 +1,3 @@
+-- 1. Loan assignment table
+DROP TABLE IF EXISTS loan_assignment;
+DROP TYPE IF EXISTS loan_assignment_status;
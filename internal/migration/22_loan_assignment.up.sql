@@ -0,0 +1,19 @@
+-- Loan assignment table.
+-- This table records which field validator is in charge of reviewing a proposed loan.
+-- A loan has at most one ASSIGNED row at a time, previous assignees are kept as COMPLETED or REASSIGNED.
+CREATE TYPE loan_assignment_status AS ENUM ('ASSIGNED', 'COMPLETED', 'REASSIGNED');
+CREATE TABLE IF NOT EXISTS loan_assignment (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    loan_id BIGINT NOT NULL REFERENCES loan(id),
+    validator_id BIGINT NOT NULL REFERENCES "user"(id),
+    status loan_assignment_status NOT NULL DEFAULT 'ASSIGNED',
+    assigned_at TIMESTAMPTZ NOT NULL,
+    closed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by BIGINT
+);
+
+CREATE UNIQUE INDEX loan_assignment_open_idx ON loan_assignment (loan_id) WHERE status = 'ASSIGNED';
+CREATE INDEX IF NOT EXISTS loan_assignment_validator_idx ON loan_assignment (validator_id, assigned_at) WHERE status = 'ASSIGNED';
@@ -0,0 +1,2 @@
+-- 1. Loan accrual end date
+ALTER TABLE loan DROP COLUMN IF EXISTS accrual_end_date;
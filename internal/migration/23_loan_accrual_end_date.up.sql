@@ -0,0 +1,4 @@
+-- Loan accrual end date.
+-- Last day a loan accrues interest, set once it leaves DISBURSED or REPAYING and cleared if it accrues again.
+-- The accrual job keeps backfilling a loan that stopped accruing while it was down, up to this date.
+ALTER TABLE loan ADD COLUMN IF NOT EXISTS accrual_end_date DATE;
@@ -0,0 +1,4 @@
+-- 1. Child tables of loan
+DROP TABLE IF EXISTS loan_rejection;
+
+-- 2. PostgreSQL cannot drop a single enum value, so 'REJECTED' stays in loan_state.
@@ -0,0 +1,17 @@
+-- Loan state.
+-- Add REJECTED state for loan proposals turned down by field validators.
+ALTER TYPE loan_state ADD VALUE IF NOT EXISTS 'REJECTED' AFTER 'APPROVED';
+
+-- Loan rejection table.
+-- This table records the rejection of loan proposals by field validators.
+-- Each rejection is linked to a specific loan and validator.
+CREATE TABLE IF NOT EXISTS loan_rejection (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    loan_id BIGINT NOT NULL REFERENCES loan(id) UNIQUE,
+    validator_id BIGINT NOT NULL REFERENCES "user"(id),
+    reason_code VARCHAR(50) NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    rejection_date DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT
+);
@@ -0,0 +1,10 @@
+-- 1. Child tables of loan
+DROP TABLE IF EXISTS loan_cancellation;
+
+-- 2. Loan investment status
+ALTER TABLE loan_investment
+    DROP COLUMN IF EXISTS reversed_at,
+    DROP COLUMN IF EXISTS status;
+DROP TYPE IF EXISTS loan_investment_status;
+
+-- 3. PostgreSQL cannot drop a single enum value, so 'CANCELLED' stays in loan_state.
@@ -0,0 +1,23 @@
+-- Loan state.
+-- Add CANCELLED state for loans withdrawn by their borrower before funding completes.
+ALTER TYPE loan_state ADD VALUE IF NOT EXISTS 'CANCELLED' AFTER 'REJECTED';
+
+-- Loan investment status.
+-- Reversed investments are kept for auditing instead of being deleted.
+CREATE TYPE loan_investment_status AS ENUM ('ACTIVE', 'REVERSED');
+ALTER TABLE loan_investment
+    ADD COLUMN IF NOT EXISTS status loan_investment_status NOT NULL DEFAULT 'ACTIVE',
+    ADD COLUMN IF NOT EXISTS reversed_at TIMESTAMPTZ;
+
+-- Loan cancellation table.
+-- This table records the cancellation of loans by their borrowers.
+CREATE TABLE IF NOT EXISTS loan_cancellation (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    loan_id BIGINT NOT NULL REFERENCES loan(id) UNIQUE,
+    borrower_id BIGINT NOT NULL REFERENCES "user"(id),
+    reason TEXT NOT NULL DEFAULT '',
+    reversed_amount NUMERIC(15,2) NOT NULL DEFAULT 0,
+    cancellation_date DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT
+);
@@ -0,0 +1,5 @@
+-- 1. Loan funding deadline
+DROP INDEX IF EXISTS loan_funding_deadline_idx;
+ALTER TABLE loan DROP COLUMN IF EXISTS funding_deadline;
+
+-- 2. PostgreSQL cannot drop a single enum value, so 'EXPIRED' stays in loan_state and 'REFUNDED' stays in loan_investment_status.
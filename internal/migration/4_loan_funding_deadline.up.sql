@@ -0,0 +1,13 @@
+-- Loan state.
+-- Add EXPIRED state for approved loans that were not fully funded before their deadline.
+ALTER TYPE loan_state ADD VALUE IF NOT EXISTS 'EXPIRED' AFTER 'CANCELLED';
+
+-- Loan investment status.
+-- Add REFUNDED status for investments released back to investors when a loan expires.
+ALTER TYPE loan_investment_status ADD VALUE IF NOT EXISTS 'REFUNDED';
+
+-- Loan funding deadline.
+-- Set when a loan is approved, loans still open after this time are expired by the background worker.
+ALTER TABLE loan ADD COLUMN IF NOT EXISTS funding_deadline TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS loan_funding_deadline_idx ON loan (funding_deadline) WHERE state IN ('APPROVED', 'FUNDING');
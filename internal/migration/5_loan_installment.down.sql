@@ -0,0 +1,8 @@
+-- 1. Child tables of loan
+DROP TABLE IF EXISTS loan_installment;
+
+-- 2. Drop enum type for loan_installment_status
+DROP TYPE IF EXISTS loan_installment_status;
+
+-- 3. Loan tenor
+ALTER TABLE loan DROP COLUMN IF EXISTS tenor_months;
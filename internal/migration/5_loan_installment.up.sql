@@ -0,0 +1,23 @@
+-- Loan tenor.
+-- Number of monthly installments the borrower repays the loan in.
+ALTER TABLE loan ADD COLUMN IF NOT EXISTS tenor_months INT NOT NULL DEFAULT 12;
+
+-- Loan installment table.
+-- This table records the repayment schedule generated when a loan is disbursed.
+-- Each installment is linked to a specific loan and ordered by its installment number.
+CREATE TYPE loan_installment_status AS ENUM ('UNPAID', 'PAID');
+CREATE TABLE IF NOT EXISTS loan_installment (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    loan_id BIGINT NOT NULL REFERENCES loan(id),
+    installment_number INT NOT NULL,
+    due_date DATE NOT NULL,
+    principal_amount NUMERIC(15,2) NOT NULL,
+    interest_amount NUMERIC(15,2) NOT NULL,
+    status loan_installment_status NOT NULL DEFAULT 'UNPAID',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by BIGINT,
+
+    UNIQUE (loan_id, installment_number)
+);
@@ -0,0 +1,12 @@
+-- 1. Child tables of loan
+DROP TABLE IF EXISTS loan_repayment;
+
+-- 2. Loan installment paid amounts
+ALTER TABLE loan_installment
+    DROP COLUMN IF EXISTS paid_at,
+    DROP COLUMN IF EXISTS paid_principal,
+    DROP COLUMN IF EXISTS paid_interest,
+    DROP COLUMN IF EXISTS paid_fee,
+    DROP COLUMN IF EXISTS fee_amount;
+
+-- 3. PostgreSQL cannot drop a single enum value, so 'REPAYING'/'CLOSED' stay in loan_state and 'PARTIALLY_PAID' stays in loan_installment_status.
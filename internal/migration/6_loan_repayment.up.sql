@@ -0,0 +1,39 @@
+-- Loan state.
+-- Add REPAYING state once the first repayment is recorded and CLOSED state once every installment is settled.
+ALTER TYPE loan_state ADD VALUE IF NOT EXISTS 'REPAYING' AFTER 'DISBURSED';
+ALTER TYPE loan_state ADD VALUE IF NOT EXISTS 'CLOSED' AFTER 'REPAYING';
+
+-- Loan installment status.
+-- Add PARTIALLY_PAID status for installments that received less than their total amount.
+ALTER TYPE loan_installment_status ADD VALUE IF NOT EXISTS 'PARTIALLY_PAID' AFTER 'UNPAID';
+
+-- Loan installment paid amounts.
+-- Fees are charged on top of the scheduled principal and interest (e.g. late fees).
+ALTER TABLE loan_installment
+    ADD COLUMN IF NOT EXISTS fee_amount NUMERIC(15,2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS paid_fee NUMERIC(15,2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS paid_interest NUMERIC(15,2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS paid_principal NUMERIC(15,2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS paid_at TIMESTAMPTZ;
+
+-- Loan repayment table.
+-- This table records every payment received from a borrower and how it was applied.
+-- Any amount left after every installment is settled is kept as excess_amount to be refunded.
+CREATE TABLE IF NOT EXISTS loan_repayment (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    loan_id BIGINT NOT NULL REFERENCES loan(id),
+    payer_id BIGINT NOT NULL REFERENCES "user"(id),
+    amount NUMERIC(15,2) NOT NULL,
+    applied_fee NUMERIC(15,2) NOT NULL DEFAULT 0,
+    applied_interest NUMERIC(15,2) NOT NULL DEFAULT 0,
+    applied_principal NUMERIC(15,2) NOT NULL DEFAULT 0,
+    excess_amount NUMERIC(15,2) NOT NULL DEFAULT 0,
+    reference TEXT NOT NULL DEFAULT '',
+    payment_date TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by BIGINT
+);
+
+CREATE INDEX loan_repayment_loan_idx ON loan_repayment (loan_id);
@@ -0,0 +1,2 @@
+-- 1. Child tables of loan_repayment
+DROP TABLE IF EXISTS investor_payout;
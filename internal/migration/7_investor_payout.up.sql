@@ -0,0 +1,22 @@
+-- Investor payout table.
+-- This table records the share of every repayment distributed to each investment of the loan.
+-- principal_amount and return_amount are split pro-rata to the invested amount and rounded so the pieces sum exactly to the distributed amounts.
+CREATE TABLE IF NOT EXISTS investor_payout (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    loan_id BIGINT NOT NULL REFERENCES loan(id),
+    repayment_id BIGINT NOT NULL REFERENCES loan_repayment(id),
+    investment_id BIGINT NOT NULL REFERENCES loan_investment(id),
+    investor_id BIGINT NOT NULL REFERENCES "user"(id),
+    principal_amount NUMERIC(15,2) NOT NULL DEFAULT 0,
+    return_amount NUMERIC(15,2) NOT NULL DEFAULT 0,
+    amount NUMERIC(15,2) NOT NULL,
+    paid_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by BIGINT,
+
+    UNIQUE (repayment_id, investment_id)
+);
+
+CREATE INDEX investor_payout_investor_idx ON investor_payout (investor_id);
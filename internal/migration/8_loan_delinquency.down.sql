@@ -0,0 +1,10 @@
+-- 1. Loan installment late fee
+ALTER TABLE loan_installment DROP COLUMN IF EXISTS late_fee_charged_at;
+
+-- 2. Loan delinquency
+ALTER TABLE loan
+    DROP COLUMN IF EXISTS first_missed_at,
+    DROP COLUMN IF EXISTS delinquency_checked_at,
+    DROP COLUMN IF EXISTS delinquency_bucket,
+    DROP COLUMN IF EXISTS days_past_due;
+DROP TYPE IF EXISTS loan_delinquency_bucket;
@@ -0,0 +1,15 @@
+-- Loan delinquency bucket.
+-- Loans are grouped by the days past due of their oldest unpaid installment.
+CREATE TYPE loan_delinquency_bucket AS ENUM ('CURRENT', 'DPD_1_30', 'DPD_31_60', 'DPD_61_90', 'DPD_90_PLUS');
+
+-- Loan delinquency.
+-- Refreshed daily by the delinquency job. first_missed_at is kept once set so investors are only notified of the first missed payment.
+ALTER TABLE loan
+    ADD COLUMN IF NOT EXISTS days_past_due INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS delinquency_bucket loan_delinquency_bucket NOT NULL DEFAULT 'CURRENT',
+    ADD COLUMN IF NOT EXISTS delinquency_checked_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS first_missed_at TIMESTAMPTZ;
+
+-- Loan installment late fee.
+-- The late fee is charged once per overdue installment and added to fee_amount.
+ALTER TABLE loan_installment ADD COLUMN IF NOT EXISTS late_fee_charged_at TIMESTAMPTZ;
@@ -0,0 +1,5 @@
+-- 1. Child tables of loan
+DROP TABLE IF EXISTS investor_loss;
+DROP TABLE IF EXISTS loan_write_off;
+
+-- 2. PostgreSQL cannot drop a single enum value, so 'DEFAULTED'/'WRITTEN_OFF' stay in loan_state.
@@ -0,0 +1,39 @@
+-- Loan state.
+-- Add DEFAULTED state for loans past due longer than 90 days and WRITTEN_OFF state for loans recognized as bad debt.
+ALTER TYPE loan_state ADD VALUE IF NOT EXISTS 'DEFAULTED' AFTER 'CLOSED';
+ALTER TYPE loan_state ADD VALUE IF NOT EXISTS 'WRITTEN_OFF' AFTER 'DEFAULTED';
+
+-- Loan write-off table.
+-- This table records the outstanding balance of a loan at the time it was written off.
+-- recovered_amount accumulates repayments received after the write-off.
+CREATE TABLE IF NOT EXISTS loan_write_off (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    loan_id BIGINT NOT NULL REFERENCES loan(id) UNIQUE,
+    outstanding_principal NUMERIC(15,2) NOT NULL,
+    outstanding_interest NUMERIC(15,2) NOT NULL DEFAULT 0,
+    outstanding_fee NUMERIC(15,2) NOT NULL DEFAULT 0,
+    recovered_amount NUMERIC(15,2) NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL DEFAULT '',
+    write_off_date TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by BIGINT
+);
+
+-- Investor loss table.
+-- This table records the share of the written off principal realized by each investment of the loan.
+CREATE TABLE IF NOT EXISTS investor_loss (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    loan_id BIGINT NOT NULL REFERENCES loan(id),
+    write_off_id BIGINT NOT NULL REFERENCES loan_write_off(id),
+    investment_id BIGINT NOT NULL REFERENCES loan_investment(id),
+    investor_id BIGINT NOT NULL REFERENCES "user"(id),
+    loss_amount NUMERIC(15,2) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by BIGINT,
+
+    UNIQUE (write_off_id, investment_id)
+);
+
+CREATE INDEX investor_loss_investor_idx ON investor_loss (investor_id);
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/money"
+)
+
+type LoanAssignment struct {
+	CommonModel
+
+	LoanId      uint64                        `json:"loan_id" db:"loan_id"`
+	ValidatorId uint64                        `json:"validator_id" db:"validator_id"`
+	Status      constant.LoanAssignmentStatus `json:"status" db:"status"`
+	AssignedAt  time.Time                     `json:"assigned_at" db:"assigned_at"`
+	ClosedAt    sql.NullTime                  `json:"closed_at" db:"closed_at"` // set once completed or reassigned
+}
+
+// ValidatorWorkload is an active field validator along with the loans awaiting their review.
+type ValidatorWorkload struct {
+	ValidatorId     uint64 `db:"validator_id"`
+	OpenAssignments uint32 `db:"open_assignments"`
+}
+
+// QueuedLoan is a loan awaiting approval without a current assignment.
+type QueuedLoan struct {
+	LoanId          uint64       `db:"loan_id"`
+	Currency        string       `db:"currency"`
+	PrincipalAmount money.Amount `db:"principal_amount"`
+	ApprovalCount   uint32       `db:"approval_count"` // approval steps done so far
+}
+
+// PendingAssignment is an open assignment along with the figures of its loan.
+type PendingAssignment struct {
+	AssignmentId    uint64       `json:"assignment_id" db:"assignment_id"`
+	LoanId          uint64       `json:"loan_id" db:"loan_id"`
+	BorrowerId      uint64       `json:"borrower_id" db:"borrower_id"`
+	PrincipalAmount money.Amount `json:"principal_amount" db:"principal_amount"`
+	Currency        string       `json:"currency" db:"currency"`
+	TenorMonths     uint32       `json:"tenor_months" db:"tenor_months"`
+	ProposedAt      time.Time    `json:"proposed_at" db:"proposed_at"`
+	AssignedAt      time.Time    `json:"assigned_at" db:"assigned_at"`
+}
+
+// -------------------- Assign Loans --------------------
+
+type AssignLoansResponse struct {
+	AssignedLoanCount uint32 `json:"assigned_loan_count"`
+}
+
+// -------------------- List My Assignments --------------------
+
+type ListMyAssignmentsRequest struct {
+	ValidatorId uint64 `json:"-"` // comes from auth context (Field Validator role)
+}
+
+type ListMyAssignmentsResponse struct {
+	Assignments []*PendingAssignment `json:"assignments"` // oldest assignment first
+}
+
+// -------------------- Reassign Loan --------------------
+
+type ReassignLoanRequest struct {
+	LoanId      uint64 `json:"loan_id" validate:"required,gte=1"`      // required
+	ValidatorId uint64 `json:"validator_id" validate:"required,gte=1"` // required, active field validator taking the loan over
+	AdminId     uint64 `json:"-"`                                      // comes from auth context
+}
+
+type ReassignLoanResponse struct {
+	Assignment *LoanAssignment `json:"assignment"`
+}
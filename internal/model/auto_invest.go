@@ -0,0 +1,60 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/money"
+)
+
+type AutoInvestRule struct {
+	CommonModel
+
+	InvestorId     uint64                 `json:"investor_id" db:"investor_id"`
+	Currency       string                 `json:"currency" db:"currency"`
+	AmountPerLoan  money.Amount           `json:"amount_per_loan" db:"amount_per_loan"`
+	MinROI         float64                `json:"min_roi" db:"min_roi"`
+	MaxPrincipal   money.Amount           `json:"max_principal" db:"max_principal"`       // zero matches any principal
+	MaxTenorMonths uint32                 `json:"max_tenor_months" db:"max_tenor_months"` // zero matches any tenor
+	MaxRiskGrade   constant.LoanRiskGrade `json:"max_risk_grade" db:"max_risk_grade"`     // empty matches any loan, graded or not
+	LastInvestedAt sql.NullTime           `json:"last_invested_at" db:"last_invested_at"`
+}
+
+// -------------------- Create Auto Invest Rule --------------------
+
+type CreateAutoInvestRuleRequest struct {
+	InvestorId     uint64       `json:"-"`                                                   // comes from auth context
+	Currency       string       `json:"currency" validate:"omitempty,iso4217"`               // optional, defaults to IDR
+	AmountPerLoan  money.Amount `json:"amount_per_loan" validate:"required,numeric,gt=0"`    // required, within the investment limits of the currency
+	MinROI         float64      `json:"min_roi" validate:"numeric,gte=0,lte=100"`            // optional
+	MaxPrincipal   money.Amount `json:"max_principal" validate:"numeric,gte=0"`              // optional
+	MaxTenorMonths uint32       `json:"max_tenor_months" validate:"lte=60"`                  // optional
+	MaxRiskGrade   string       `json:"max_risk_grade" validate:"omitempty,oneof=A B C D E"` // optional
+}
+
+type CreateAutoInvestRuleResponse struct {
+	Rule *AutoInvestRule `json:"rule"`
+}
+
+// -------------------- List Auto Invest Rules --------------------
+
+type ListAutoInvestRulesRequest struct {
+	InvestorId uint64 `json:"-"` // comes from auth context
+}
+
+type ListAutoInvestRulesResponse struct {
+	Rules []*AutoInvestRule `json:"rules"`
+}
+
+// -------------------- Delete Auto Invest Rule --------------------
+
+type DeleteAutoInvestRuleRequest struct {
+	RuleId     uint64 `json:"rule_id" validate:"required,gte=1"` // required
+	InvestorId uint64 `json:"-"`                                 // comes from auth context
+}
+
+type DeleteAutoInvestRuleResponse struct {
+	RuleId    uint64    `json:"rule_id"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
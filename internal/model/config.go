@@ -6,6 +6,8 @@ type AppConfig struct {
 	Jwt        JwtConfig
 	Auth       AuthConfig
 	Dependency DependencyConfig
+	Loan       LoanConfig
+	Worker     WorkerConfig
 }
 
 type Encryption struct {
@@ -17,3 +19,40 @@ type AuthConfig struct {
 }
 
 type DependencyConfig struct{}
+
+type LoanConfig struct {
+	FundingPeriod      string               // How long an approved loan stays open for investment, e.g. 720h.
+	LateFee            float64              // Flat fee charged once on every installment that becomes overdue. Zero disables it. Only used when Currencies is empty.
+	Currencies         []CurrencyConfig     // Supported currencies. Empty supports the default currency only, with the default limits.
+	MaxLoanShare       float64              // Maximum percentage of a loan principal a single investor may hold, e.g. 25. Zero disables it.
+	ReservationPeriod  string               // How long a reservation holds part of a loan before it must be confirmed, e.g. 15m.
+	CoolingOffPeriod   string               // How long after investing an investor may cancel the investment while the loan is still funding, e.g. 24h.
+	DayCountConvention string               // Day-count convention of the daily interest accrual, ACT/365 or 30/360. Defaults to ACT/365.
+	ApprovalTiers      []ApprovalTierConfig // Approval steps required above principal thresholds. Only used when Currencies is empty.
+	AssignmentStrategy string               // How proposed loans are assigned to field validators, ROUND_ROBIN or LEAST_LOADED. Defaults to LEAST_LOADED.
+}
+
+type CurrencyConfig struct {
+	Code          string               // ISO 4217 code, e.g. IDR.
+	MinPrincipal  float64              // Minimum principal of a loan.
+	MaxPrincipal  float64              // Maximum principal of a loan.
+	MinInvestment float64              // Minimum amount of a single investment.
+	MaxInvestment float64              // Maximum amount of a single investment.
+	LateFee       float64              // Flat fee charged once on every installment that becomes overdue. Zero disables it.
+	CreditLimit   float64              // Credit limit of a borrower without one set.
+	MaxExposure   float64              // Maximum amount an investor may hold across the open loans of a single borrower. Zero disables it.
+	ApprovalTiers []ApprovalTierConfig // Approval steps required above principal thresholds. Loans below every threshold need a single field validator approval.
+}
+
+type ApprovalTierConfig struct {
+	Threshold float64 // Loans with a principal above it go through this tier, the tier with the highest threshold applies.
+	Steps     []uint8 // Role IDs that must approve in order, e.g. [3, 2] for a field validator then an admin. More senior staff roles may act for a step.
+}
+
+type WorkerConfig struct {
+	LoanExpiryInterval        string // How often overdue loans are expired, e.g. 1h. Empty disables the job.
+	DelinquencyInterval       string // How often days-past-due of repaying loans are computed, e.g. 24h. Empty disables the job.
+	ReservationExpiryInterval string // How often unconfirmed investment reservations past their expiry are released, e.g. 1m. Empty disables the job.
+	InterestAccrualInterval   string // How often daily interest accruals are posted for disbursed loans, e.g. 1h. Empty disables the job.
+	LoanAssignmentInterval    string // How often proposed loans left without a field validator are assigned, e.g. 5m. Empty disables the job.
+}
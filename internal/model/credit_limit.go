@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/money"
+)
+
+type BorrowerCreditLimit struct {
+	CommonModel
+
+	BorrowerId  uint64       `json:"borrower_id" db:"borrower_id"`
+	Currency    string       `json:"currency" db:"currency"`
+	LimitAmount money.Amount `json:"limit_amount" db:"limit_amount"`
+}
+
+type BorrowerCreditLimitAudit struct {
+	CommonModel
+
+	BorrowerId    uint64        `json:"borrower_id" db:"borrower_id"`
+	Currency      string        `json:"currency" db:"currency"`
+	PreviousLimit *money.Amount `json:"previous_limit" db:"previous_limit"` // nil when the default limit applied
+	NewLimit      money.Amount  `json:"new_limit" db:"new_limit"`
+	Reason        string        `json:"reason" db:"reason"`
+	ChangedBy     uint64        `json:"changed_by" db:"changed_by"`
+}
+
+// -------------------- Get Borrower Credit Limit --------------------
+
+type GetBorrowerCreditLimitRequest struct {
+	BorrowerId uint64 `json:"borrower_id" validate:"required,gte=1"` // required
+	Currency   string `json:"currency" validate:"omitempty,iso4217"` // optional, defaults to IDR
+}
+
+type GetBorrowerCreditLimitResponse struct {
+	BorrowerId      uint64       `json:"borrower_id"`
+	Currency        string       `json:"currency"`
+	LimitAmount     money.Amount `json:"limit_amount"`
+	IsDefault       bool         `json:"is_default"`       // no limit was set for the borrower
+	ExposureAmount  money.Amount `json:"exposure_amount"`  // principal of the open loans of the borrower
+	AvailableAmount money.Amount `json:"available_amount"` // principal the borrower may still request
+	UpdatedAt       time.Time    `json:"updated_at"`       // zero when the default applies
+}
+
+// -------------------- Set Borrower Credit Limit --------------------
+
+type SetBorrowerCreditLimitRequest struct {
+	BorrowerId  uint64       `json:"borrower_id" validate:"required,gte=1"`         // required
+	AdminId     uint64       `json:"-"`                                             // comes from auth context
+	Currency    string       `json:"currency" validate:"omitempty,iso4217"`         // optional, defaults to IDR
+	LimitAmount money.Amount `json:"limit_amount" validate:"required,numeric,gt=0"` // required
+	Reason      string       `json:"reason" validate:"required,max=500"`            // required
+}
+
+type SetBorrowerCreditLimitResponse struct {
+	BorrowerId      uint64       `json:"borrower_id"`
+	Currency        string       `json:"currency"`
+	PreviousLimit   money.Amount `json:"previous_limit"`
+	LimitAmount     money.Amount `json:"limit_amount"`
+	ExposureAmount  money.Amount `json:"exposure_amount"`
+	AvailableAmount money.Amount `json:"available_amount"`
+	UpdatedAt       time.Time    `json:"updated_at"`
+}
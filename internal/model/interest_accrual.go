@@ -0,0 +1,56 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/money"
+)
+
+type LoanInterestAccrual struct {
+	CommonModel
+
+	LoanId             uint64                      `json:"loan_id" db:"loan_id"`
+	AccrualDate        time.Time                   `json:"accrual_date" db:"accrual_date"`
+	PrincipalAmount    money.Amount                `json:"principal_amount" db:"principal_amount"` // outstanding principal at the end of the day
+	InterestRate       float64                     `json:"interest_rate" db:"interest_rate"`
+	DayCountConvention constant.DayCountConvention `json:"day_count_convention" db:"day_count_convention"`
+	Amount             money.Amount                `json:"amount" db:"amount"`
+	Currency           string                      `json:"currency" db:"currency"`
+}
+
+// LoanInterestAccrualSummary aggregates the daily accruals of a loan.
+type LoanInterestAccrualSummary struct {
+	AccruedAmount    money.Amount `db:"accrued_amount"`
+	AccruedDays      uint32       `db:"accrued_days"`
+	FirstAccrualDate sql.NullTime `db:"first_accrual_date"`
+	LastAccrualDate  sql.NullTime `db:"last_accrual_date"`
+}
+
+// -------------------- Accrue Interest --------------------
+
+type AccrueInterestResponse struct {
+	AccruedLoanCount   uint32 `json:"accrued_loan_count"`
+	PostedAccrualCount uint32 `json:"posted_accrual_count"`
+}
+
+// -------------------- Get Accrued Interest --------------------
+
+type GetAccruedInterestRequest struct {
+	LoanId uint64 `json:"loan_id" validate:"required,gte=1"` // required
+	UserId uint64 `json:"-"`                                 // comes from auth context
+	RoleId uint8  `json:"-"`                                 // comes from auth context
+}
+
+type GetAccruedInterestResponse struct {
+	LoanId             uint64       `json:"loan_id"`
+	Currency           string       `json:"currency"`
+	State              string       `json:"state"`
+	InterestRate       float64      `json:"interest_rate"`
+	DayCountConvention string       `json:"day_count_convention"`
+	AccruedAmount      money.Amount `json:"accrued_amount"`     // sum of the daily accruals posted so far
+	AccruedDays        uint32       `json:"accrued_days"`       // number of daily accruals posted so far
+	FirstAccrualDate   time.Time    `json:"first_accrual_date"` // zero when nothing accrued yet
+	LastAccrualDate    time.Time    `json:"last_accrual_date"`  // zero when nothing accrued yet
+}
@@ -1,21 +1,34 @@
 package model
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/money"
 )
 
 type Loan struct {
 	CommonModel
 
-	BorrowerId      uint64             `json:"borrower_id" db:"borrower_id"`
-	PrincipalAmount float64            `json:"principal_amount" db:"principal_amount"`
-	InterestRate    float64            `json:"interest_rate" db:"interest_rate"`
-	ROI             float64            `json:"roi" db:"roi"`
-	AgreementLink   *string            `json:"agreement_link" db:"agreement_link"`
-	State           constant.LoanState `json:"state" db:"state"`
-	InvestedAmount  float64            `json:"invested_amount" db:"invested_amount"`
+	BorrowerId      uint64                 `json:"borrower_id" db:"borrower_id"`
+	PrincipalAmount money.Amount           `json:"principal_amount" db:"principal_amount"`
+	Currency        string                 `json:"currency" db:"currency"`
+	InterestRate    float64                `json:"interest_rate" db:"interest_rate"`
+	ROI             float64                `json:"roi" db:"roi"`
+	AgreementLink   *string                `json:"agreement_link" db:"agreement_link"`
+	State           constant.LoanState     `json:"state" db:"state"`
+	InvestedAmount  money.Amount           `json:"invested_amount" db:"invested_amount"`
+	FundingDeadline sql.NullTime           `json:"funding_deadline" db:"funding_deadline"`
+	TenorMonths     uint32                 `json:"tenor_months" db:"tenor_months"`
+	RiskGrade       constant.LoanRiskGrade `json:"risk_grade" db:"risk_grade"`
+
+	DaysPastDue          uint32                         `json:"days_past_due" db:"days_past_due"`
+	DelinquencyBucket    constant.LoanDelinquencyBucket `json:"delinquency_bucket" db:"delinquency_bucket"`
+	DelinquencyCheckedAt sql.NullTime                   `json:"delinquency_checked_at" db:"delinquency_checked_at"`
+	FirstMissedAt        sql.NullTime                   `json:"first_missed_at" db:"first_missed_at"`
+
+	AccrualEndDate sql.NullTime `json:"accrual_end_date" db:"accrual_end_date"` // last day accruing interest, once out of DISBURSED and REPAYING
 }
 
 type LoanApproval struct {
@@ -23,17 +36,47 @@ type LoanApproval struct {
 
 	LoanId         uint64    `json:"loan_id" db:"loan_id"`
 	ValidatorId    uint64    `json:"validator_id" db:"validator_id"`
-	PhotoProofLink string    `json:"photo_proof_link" db:"photo_proof_link"`
+	RoleId         uint8     `json:"role_id" db:"role_id"`
+	Step           uint32    `json:"step" db:"step"`
+	RequiredSteps  uint32    `json:"required_steps" db:"required_steps"`
+	PhotoProofLink *string   `json:"photo_proof_link" db:"photo_proof_link"` // first step only
 	ApprovalDate   time.Time `json:"approval_date" db:"approval_date"`
 }
 
+type LoanRejection struct {
+	CommonModel
+
+	LoanId        uint64                       `json:"loan_id" db:"loan_id"`
+	ValidatorId   uint64                       `json:"validator_id" db:"validator_id"`
+	ReasonCode    constant.LoanRejectionReason `json:"reason_code" db:"reason_code"`
+	Note          string                       `json:"note" db:"note"`
+	RejectionDate time.Time                    `json:"rejection_date" db:"rejection_date"`
+}
+
 type LoanInvestment struct {
 	CommonModel
 
-	LoanId     uint64    `json:"loan_id" db:"loan_id"`
-	InvestorId uint64    `json:"investor_id" db:"investor_id"`
-	Amount     float64   `json:"amount" db:"amount"`
-	InvestedAt time.Time `json:"invested_at" db:"invested_at"`
+	LoanId     uint64                        `json:"loan_id" db:"loan_id"`
+	InvestorId uint64                        `json:"investor_id" db:"investor_id"`
+	Amount     money.Amount                  `json:"amount" db:"amount"`
+	Currency   string                        `json:"currency" db:"currency"`
+	InvestedAt time.Time                     `json:"invested_at" db:"invested_at"`
+	Status     constant.LoanInvestmentStatus `json:"status" db:"status"`
+	ReversedAt sql.NullTime                  `json:"reversed_at" db:"reversed_at"`
+}
+
+type LoanInvestmentReservation struct {
+	CommonModel
+
+	LoanId       uint64                                   `json:"loan_id" db:"loan_id"`
+	InvestorId   uint64                                   `json:"investor_id" db:"investor_id"`
+	Amount       money.Amount                             `json:"amount" db:"amount"`
+	Currency     string                                   `json:"currency" db:"currency"`
+	Status       constant.LoanInvestmentReservationStatus `json:"status" db:"status"`
+	ExpiresAt    time.Time                                `json:"expires_at" db:"expires_at"`
+	InvestmentId sql.NullInt64                            `json:"investment_id" db:"investment_id"`
+	ConfirmedAt  sql.NullTime                             `json:"confirmed_at" db:"confirmed_at"`
+	ReleasedAt   sql.NullTime                             `json:"released_at" db:"released_at"`
 }
 
 type LoanDisbursement struct {
@@ -45,47 +88,295 @@ type LoanDisbursement struct {
 	DisbursementDate    time.Time `json:"disbursement_date" db:"disbursement_date"`
 }
 
+type LoanAgreement struct {
+	CommonModel
+
+	LoanId          uint64                     `json:"loan_id" db:"loan_id"`
+	Type            constant.LoanAgreementType `json:"type" db:"type"`
+	InvestorId      sql.NullInt64              `json:"investor_id" db:"investor_id"` // null for the borrower agreement
+	TemplateVersion string                     `json:"template_version" db:"template_version"`
+	DocumentLink    string                     `json:"document_link" db:"document_link"`
+}
+
+type LoanCancellation struct {
+	CommonModel
+
+	LoanId           uint64       `json:"loan_id" db:"loan_id"`
+	BorrowerId       uint64       `json:"borrower_id" db:"borrower_id"`
+	Reason           string       `json:"reason" db:"reason"`
+	ReversedAmount   money.Amount `json:"reversed_amount" db:"reversed_amount"`
+	CancellationDate time.Time    `json:"cancellation_date" db:"cancellation_date"`
+}
+
+type LoanInstallment struct {
+	CommonModel
+
+	LoanId            uint64                         `json:"loan_id" db:"loan_id"`
+	InstallmentNumber uint32                         `json:"installment_number" db:"installment_number"`
+	DueDate           time.Time                      `json:"due_date" db:"due_date"`
+	PrincipalAmount   money.Amount                   `json:"principal_amount" db:"principal_amount"`
+	InterestAmount    money.Amount                   `json:"interest_amount" db:"interest_amount"`
+	FeeAmount         money.Amount                   `json:"fee_amount" db:"fee_amount"`
+	PaidFee           money.Amount                   `json:"paid_fee" db:"paid_fee"`
+	PaidInterest      money.Amount                   `json:"paid_interest" db:"paid_interest"`
+	PaidPrincipal     money.Amount                   `json:"paid_principal" db:"paid_principal"`
+	PaidAt            sql.NullTime                   `json:"paid_at" db:"paid_at"`
+	LateFeeChargedAt  sql.NullTime                   `json:"late_fee_charged_at" db:"late_fee_charged_at"`
+	Status            constant.LoanInstallmentStatus `json:"status" db:"status"`
+}
+
+type LoanRepayment struct {
+	CommonModel
+
+	LoanId           uint64       `json:"loan_id" db:"loan_id"`
+	PayerId          uint64       `json:"payer_id" db:"payer_id"`
+	Amount           money.Amount `json:"amount" db:"amount"`
+	AppliedFee       money.Amount `json:"applied_fee" db:"applied_fee"`
+	AppliedInterest  money.Amount `json:"applied_interest" db:"applied_interest"`
+	AppliedPrincipal money.Amount `json:"applied_principal" db:"applied_principal"`
+	ExcessAmount     money.Amount `json:"excess_amount" db:"excess_amount"`
+	Reference        string       `json:"reference" db:"reference"`
+	PaymentDate      time.Time    `json:"payment_date" db:"payment_date"`
+}
+
+type LoanWriteOff struct {
+	CommonModel
+
+	LoanId               uint64       `json:"loan_id" db:"loan_id"`
+	OutstandingPrincipal money.Amount `json:"outstanding_principal" db:"outstanding_principal"`
+	OutstandingInterest  money.Amount `json:"outstanding_interest" db:"outstanding_interest"`
+	OutstandingFee       money.Amount `json:"outstanding_fee" db:"outstanding_fee"`
+	RecoveredAmount      money.Amount `json:"recovered_amount" db:"recovered_amount"`
+	Reason               string       `json:"reason" db:"reason"`
+	WriteOffDate         time.Time    `json:"write_off_date" db:"write_off_date"`
+}
+
+type InvestorLoss struct {
+	CommonModel
+
+	LoanId       uint64       `json:"loan_id" db:"loan_id"`
+	WriteOffId   uint64       `json:"write_off_id" db:"write_off_id"`
+	InvestmentId uint64       `json:"investment_id" db:"investment_id"`
+	InvestorId   uint64       `json:"investor_id" db:"investor_id"`
+	LossAmount   money.Amount `json:"loss_amount" db:"loss_amount"`
+}
+
+type LoanRestructure struct {
+	CommonModel
+
+	LoanId               uint64                         `json:"loan_id" db:"loan_id"`
+	Status               constant.LoanRestructureStatus `json:"status" db:"status"`
+	ExtendTenorMonths    uint32                         `json:"extend_tenor_months" db:"extend_tenor_months"`
+	GracePeriodMonths    uint32                         `json:"grace_period_months" db:"grace_period_months"`
+	PreviousInterestRate float64                        `json:"previous_interest_rate" db:"previous_interest_rate"`
+	NewInterestRate      float64                        `json:"new_interest_rate" db:"new_interest_rate"`
+	PreviousTenorMonths  uint32                         `json:"previous_tenor_months" db:"previous_tenor_months"`
+	NewTenorMonths       uint32                         `json:"new_tenor_months" db:"new_tenor_months"`
+	CapitalizedAmount    money.Amount                   `json:"capitalized_amount" db:"capitalized_amount"`
+	Reason               string                         `json:"reason" db:"reason"`
+	RequestedBy          uint64                         `json:"requested_by" db:"requested_by"`
+	ReviewedBy           sql.NullInt64                  `json:"reviewed_by" db:"reviewed_by"`
+	ReviewNote           string                         `json:"review_note" db:"review_note"`
+	ReviewedAt           sql.NullTime                   `json:"reviewed_at" db:"reviewed_at"`
+}
+
+type InvestorPayout struct {
+	CommonModel
+
+	LoanId          uint64       `json:"loan_id" db:"loan_id"`
+	RepaymentId     uint64       `json:"repayment_id" db:"repayment_id"`
+	InvestmentId    uint64       `json:"investment_id" db:"investment_id"`
+	InvestorId      uint64       `json:"investor_id" db:"investor_id"`
+	PrincipalAmount money.Amount `json:"principal_amount" db:"principal_amount"`
+	ReturnAmount    money.Amount `json:"return_amount" db:"return_amount"`
+	Amount          money.Amount `json:"amount" db:"amount"`
+	PaidAt          time.Time    `json:"paid_at" db:"paid_at"`
+}
+
 // -------------------- Create Loan --------------------
 
 type CreateLoanRequest struct {
-	BorrowerId      uint64  `json:"-"`                                                                      // comes from auth context
-	PrincipalAmount float64 `json:"principal_amount" validate:"required,numeric,gte=1_000,lte=100_000_000"` // required
+	BorrowerId      uint64       `json:"-"`                                                 // comes from auth context
+	PrincipalAmount money.Amount `json:"principal_amount" validate:"required,numeric,gt=0"` // required, within the limits of the currency
+	Currency        string       `json:"currency" validate:"omitempty,iso4217"`             // optional, defaults to IDR
+	TenorMonths     uint32       `json:"tenor_months" validate:"lte=60"`                    // optional, defaults to 12 months
 }
 
 type CreateLoanResponse struct {
-	LoanId    uint64    `json:"loan_id"`
-	State     string    `json:"state"` // PROPOSED
-	CreatedAt time.Time `json:"created_at"`
+	LoanId      uint64    `json:"loan_id"`
+	Currency    string    `json:"currency"`
+	State       string    `json:"state"` // PROPOSED
+	CreatedAt   time.Time `json:"created_at"`
+	TenorMonths uint32    `json:"tenor_months"`
+}
+
+// -------------------- Get Loan --------------------
+
+type GetLoanRequest struct {
+	LoanId uint64 `json:"loan_id" validate:"required,gte=1"` // required
+	UserId uint64 `json:"-"`                                 // comes from auth context
+	RoleId uint8  `json:"-"`                                 // comes from auth context
+}
+
+type GetLoanResponse struct {
+	Loan *Loan `json:"loan"`
+}
+
+// -------------------- List Loans --------------------
+
+type ListLoansRequest struct {
+	UserId       uint64       `json:"-"`                                                                            // comes from auth context
+	RoleId       uint8        `json:"-"`                                                                            // comes from auth context
+	States       []string     `json:"states"`                                                                       // optional, every state if empty
+	BorrowerId   uint64       `json:"borrower_id"`                                                                  // optional, ignored for borrowers who only see their own loans
+	MinPrincipal money.Amount `json:"min_principal" validate:"numeric,gte=0"`                                       // optional
+	MaxPrincipal money.Amount `json:"max_principal" validate:"numeric,gte=0"`                                       // optional
+	CreatedFrom  string       `json:"created_from" validate:"omitempty,datetime=2006-01-02"`                        // optional, inclusive
+	CreatedTo    string       `json:"created_to" validate:"omitempty,datetime=2006-01-02"`                          // optional, inclusive
+	SortBy       string       `json:"sort_by" validate:"omitempty,oneof=created_at principal_amount interest_rate"` // optional, defaults to created_at
+	SortOrder    string       `json:"sort_order" validate:"omitempty,oneof=asc desc"`                               // optional, defaults to desc
+	Limit        uint32       `json:"limit" validate:"lte=100"`                                                     // optional, defaults to 20
+	Cursor       string       `json:"cursor"`                                                                       // optional, next_cursor of the previous page
+}
+
+type ListLoansResponse struct {
+	Loans      []*Loan `json:"loans"`
+	NextCursor string  `json:"next_cursor"` // empty on the last page
+}
+
+// LoanFilter narrows down and orders a loan listing. Zero values apply no restriction.
+type LoanFilter struct {
+	States       []constant.LoanState
+	BorrowerId   uint64
+	MinPrincipal money.Amount
+	MaxPrincipal money.Amount
+	CreatedFrom  time.Time // inclusive
+	CreatedTo    time.Time // exclusive
+
+	// Limits the listing to loans in MarketStates and loans the investor holds an active investment in.
+	InvestorId   uint64
+	MarketStates []constant.LoanState
+
+	SortBy     constant.LoanSortField
+	Descending bool
+	AfterValue string // sort value of the last loan of the previous page, as text
+	AfterId    uint64 // ID of the last loan of the previous page, zero for the first page
+	Limit      uint32
 }
 
 // -------------------- Approve Loan --------------------
 
 type ApproveLoanRequest struct {
-	LoanId         uint64  `json:"loan_id" validate:"required,gte=1"`                       // required
-	ValidatorId    uint64  `json:"-"`                                                       // comes from auth context (Field Validator role)
-	PhotoProofLink string  `json:"photo_proof_link" validate:"required,url"`                // required, link to photo proof of validation
-	InterestRate   float64 `json:"interest_rate" validate:"required,numeric,gte=0,lte=100"` // % interest borrower pays
-	ROI            float64 `json:"roi" validate:"required,numeric,gte=0,lte=100"`           // % return for investors
+	LoanId         uint64  `json:"loan_id" validate:"required,gte=1"`                        // required
+	ValidatorId    uint64  `json:"-"`                                                        // comes from auth context (Field Validator role)
+	RoleId         uint8   `json:"-"`                                                        // comes from auth context
+	PhotoProofLink string  `json:"photo_proof_link" validate:"omitempty,url"`                // required on the first step, link to photo proof of validation
+	InterestRate   float64 `json:"interest_rate" validate:"omitempty,numeric,gte=0,lte=100"` // required on the first step, % interest borrower pays
+	ROI            float64 `json:"roi" validate:"omitempty,numeric,gte=0,lte=100"`           // required on the first step, % return for investors
+	RiskGrade      string  `json:"risk_grade" validate:"omitempty,oneof=A B C D E"`          // optional, A (lowest risk) to E, first step only
 }
 
 type ApproveLoanResponse struct {
-	LoanId       uint64    `json:"loan_id"`
-	State        string    `json:"state"` // APPROVED
-	ApprovalDate time.Time `json:"approval_date"`
+	LoanId          uint64    `json:"loan_id"`
+	State           string    `json:"state"` // APPROVED once every required step is done, PROPOSED otherwise
+	ApprovalDate    time.Time `json:"approval_date"`
+	FundingDeadline time.Time `json:"funding_deadline"` // zero until APPROVED
+	RiskGrade       string    `json:"risk_grade"`
+	ApprovalStep    uint32    `json:"approval_step"`
+	RequiredSteps   uint32    `json:"required_steps"`
+}
+
+// -------------------- Reject Loan --------------------
+
+type RejectLoanRequest struct {
+	LoanId      uint64 `json:"loan_id" validate:"required,gte=1"`                                                                                        // required
+	ValidatorId uint64 `json:"-"`                                                                                                                        // comes from auth context (Field Validator role)
+	RoleId      uint8  `json:"-"`                                                                                                                        // comes from auth context
+	ReasonCode  string `json:"reason_code" validate:"required,oneof=INCOMPLETE_DOCUMENTS FAILED_VERIFICATION INSUFFICIENT_INCOME FRAUD_SUSPECTED OTHER"` // required
+	Note        string `json:"note" validate:"max=500"`                                                                                                  // optional, free-text explanation
+}
+
+type RejectLoanResponse struct {
+	LoanId        uint64    `json:"loan_id"`
+	State         string    `json:"state"` // REJECTED
+	RejectionDate time.Time `json:"rejection_date"`
 }
 
 // -------------------- Invest in Loan --------------------
 
 type InvestInLoanRequest struct {
-	LoanId     uint64  `json:"loan_id"`
-	InvestorId uint64  `json:"investor_id"` // comes from auth context
-	Amount     float64 `json:"amount" validate:"required,numeric,gte=1000"`
+	LoanId     uint64       `json:"loan_id"`
+	InvestorId uint64       `json:"investor_id"`                             // comes from auth context
+	RoleId     uint8        `json:"-"`                                       // comes from auth context
+	Amount     money.Amount `json:"amount" validate:"required,numeric,gt=0"` // required, within the limits of the currency
+	Currency   string       `json:"currency" validate:"omitempty,iso4217"`   // optional, defaults to IDR, must match the loan currency
 }
 
 type InvestInLoanResponse struct {
-	LoanId         uint64  `json:"loan_id"`
-	InvestedAmount float64 `json:"invested_amount"`
-	State          string  `json:"state"` // FUNDING or INVESTED
+	LoanId         uint64       `json:"loan_id"`
+	InvestedAmount money.Amount `json:"invested_amount"`
+	Currency       string       `json:"currency"`
+	State          string       `json:"state"` // FUNDING or INVESTED
+}
+
+// -------------------- Reserve Investment --------------------
+
+type ReserveInvestmentRequest struct {
+	LoanId     uint64       `json:"loan_id" validate:"required,gte=1"`       // required
+	InvestorId uint64       `json:"-"`                                       // comes from auth context
+	RoleId     uint8        `json:"-"`                                       // comes from auth context
+	Amount     money.Amount `json:"amount" validate:"required,numeric,gt=0"` // required, within the limits of the currency
+	Currency   string       `json:"currency" validate:"omitempty,iso4217"`   // optional, defaults to IDR, must match the loan currency
+}
+
+type ReserveInvestmentResponse struct {
+	ReservationId   uint64       `json:"reservation_id"`
+	LoanId          uint64       `json:"loan_id"`
+	Amount          money.Amount `json:"amount"`
+	Currency        string       `json:"currency"`
+	ExpiresAt       time.Time    `json:"expires_at"`
+	AvailableAmount money.Amount `json:"available_amount"` // capacity of the loan left to other investors
+}
+
+// -------------------- Confirm Investment --------------------
+
+type ConfirmInvestmentRequest struct {
+	ReservationId uint64 `json:"reservation_id" validate:"required,gte=1"` // required
+	InvestorId    uint64 `json:"-"`                                        // comes from auth context
+	RoleId        uint8  `json:"-"`                                        // comes from auth context
+}
+
+type ConfirmInvestmentResponse struct {
+	ReservationId  uint64       `json:"reservation_id"`
+	InvestmentId   uint64       `json:"investment_id"`
+	LoanId         uint64       `json:"loan_id"`
+	InvestedAmount money.Amount `json:"invested_amount"`
+	Currency       string       `json:"currency"`
+	State          string       `json:"state"` // FUNDING or INVESTED
+}
+
+// -------------------- Cancel Investment --------------------
+
+type CancelInvestmentRequest struct {
+	InvestmentId uint64 `json:"investment_id" validate:"required,gte=1"` // required
+	InvestorId   uint64 `json:"-"`                                       // comes from auth context
+	RoleId       uint8  `json:"-"`                                       // comes from auth context
+}
+
+type CancelInvestmentResponse struct {
+	InvestmentId   uint64       `json:"investment_id"`
+	LoanId         uint64       `json:"loan_id"`
+	Amount         money.Amount `json:"amount"`
+	Currency       string       `json:"currency"`
+	State          string       `json:"state"`           // FUNDING or APPROVED
+	InvestedAmount money.Amount `json:"invested_amount"` // left invested in the loan
+	CancelledAt    time.Time    `json:"cancelled_at"`
+}
+
+// -------------------- Release Expired Reservations --------------------
+
+type ReleaseExpiredReservationsResponse struct {
+	ReleasedReservationIds []uint64 `json:"released_reservation_ids"`
 }
 
 // -------------------- Disburse Loan --------------------
@@ -93,6 +384,7 @@ type InvestInLoanResponse struct {
 type DisburseLoanRequest struct {
 	LoanId              uint64 `json:"loan_id"`
 	OfficerId           uint64 `json:"-"`                                             // comes from auth context (Admin role)
+	RoleId              uint8  `json:"-"`                                             // comes from auth context
 	SignedAgreementLink string `json:"signed_agreement_link" validate:"required,url"` // proof of signed contract
 }
 
@@ -101,3 +393,222 @@ type DisburseLoanResponse struct {
 	State            string    `json:"state"` // DISBURSED
 	DisbursementDate time.Time `json:"disbursement_date"`
 }
+
+// -------------------- Cancel Loan --------------------
+
+type CancelLoanRequest struct {
+	LoanId     uint64 `json:"loan_id" validate:"required,gte=1"` // required
+	BorrowerId uint64 `json:"-"`                                 // comes from auth context
+	RoleId     uint8  `json:"-"`                                 // comes from auth context
+	Reason     string `json:"reason" validate:"max=500"`         // optional, free-text explanation
+}
+
+type CancelLoanResponse struct {
+	LoanId                  uint64       `json:"loan_id"`
+	State                   string       `json:"state"` // CANCELLED
+	ReversedAmount          money.Amount `json:"reversed_amount"`
+	Currency                string       `json:"currency"`
+	ReversedInvestmentCount uint32       `json:"reversed_investment_count"`
+	CancellationDate        time.Time    `json:"cancellation_date"`
+}
+
+// -------------------- Expire Loans --------------------
+
+type ExpireLoansResponse struct {
+	ExpiredLoanIds  []uint64                `json:"expired_loan_ids"`
+	RefundedAmounts map[string]money.Amount `json:"refunded_amounts"` // keyed by currency
+}
+
+// -------------------- Get Repayment Schedule --------------------
+
+type GetRepaymentScheduleRequest struct {
+	LoanId uint64 `json:"loan_id" validate:"required,gte=1"` // required
+	UserId uint64 `json:"-"`                                 // comes from auth context
+	RoleId uint8  `json:"-"`                                 // comes from auth context
+}
+
+type Installment struct {
+	InstallmentNumber uint32       `json:"installment_number"`
+	DueDate           time.Time    `json:"due_date"`
+	PrincipalAmount   money.Amount `json:"principal_amount"`
+	InterestAmount    money.Amount `json:"interest_amount"`
+	FeeAmount         money.Amount `json:"fee_amount"`
+	TotalAmount       money.Amount `json:"total_amount"`
+	PaidAmount        money.Amount `json:"paid_amount"`
+	Status            string       `json:"status"`
+}
+
+type GetRepaymentScheduleResponse struct {
+	LoanId         uint64         `json:"loan_id"`
+	State          string         `json:"state"`
+	TenorMonths    uint32         `json:"tenor_months"`
+	InterestRate   float64        `json:"interest_rate"`
+	Currency       string         `json:"currency"`
+	TotalPrincipal money.Amount   `json:"total_principal"`
+	TotalInterest  money.Amount   `json:"total_interest"`
+	Installments   []*Installment `json:"installments"`
+}
+
+// -------------------- Record Repayment --------------------
+
+type RecordRepaymentRequest struct {
+	LoanId    uint64       `json:"loan_id" validate:"required,gte=1"`       // required
+	PayerId   uint64       `json:"-"`                                       // comes from auth context
+	RoleId    uint8        `json:"-"`                                       // comes from auth context
+	Amount    money.Amount `json:"amount" validate:"required,numeric,gt=0"` // required
+	Reference string       `json:"reference" validate:"max=100"`            // optional, e.g. bank transfer reference
+}
+
+type RecordRepaymentResponse struct {
+	LoanId                  uint64       `json:"loan_id"`
+	RepaymentId             uint64       `json:"repayment_id"`
+	State                   string       `json:"state"` // REPAYING or CLOSED
+	Currency                string       `json:"currency"`
+	AppliedFee              money.Amount `json:"applied_fee"`
+	AppliedInterest         money.Amount `json:"applied_interest"`
+	AppliedPrincipal        money.Amount `json:"applied_principal"`
+	ExcessAmount            money.Amount `json:"excess_amount"`      // overpayment to be refunded to the payer
+	OutstandingAmount       money.Amount `json:"outstanding_amount"` // remaining amount of the whole schedule
+	SettledInstallmentCount uint32       `json:"settled_installment_count"`
+	PaymentDate             time.Time    `json:"payment_date"`
+}
+
+// -------------------- Delinquency --------------------
+
+type TrackDelinquencyResponse struct {
+	CheckedLoanCount    uint32   `json:"checked_loan_count"`
+	DelinquentLoanIds   []uint64 `json:"delinquent_loan_ids"`
+	ChargedLateFeeCount uint32   `json:"charged_late_fee_count"`
+}
+
+type GetLoanDelinquencyRequest struct {
+	LoanId uint64 `json:"loan_id" validate:"required,gte=1"` // required
+	UserId uint64 `json:"-"`                                 // comes from auth context
+	RoleId uint8  `json:"-"`                                 // comes from auth context
+}
+
+type GetLoanDelinquencyResponse struct {
+	LoanId               uint64       `json:"loan_id"`
+	State                string       `json:"state"`
+	DaysPastDue          uint32       `json:"days_past_due"`
+	DelinquencyBucket    string       `json:"delinquency_bucket"`
+	Currency             string       `json:"currency"`
+	OverdueInstallments  uint32       `json:"overdue_installments"`
+	OverdueAmount        money.Amount `json:"overdue_amount"` // unpaid amount of overdue installments including late fees
+	LateFeeAmount        money.Amount `json:"late_fee_amount"`
+	OldestDueDate        time.Time    `json:"oldest_due_date"`
+	DelinquencyCheckedAt time.Time    `json:"delinquency_checked_at"`
+}
+
+// -------------------- Write Off Loan --------------------
+
+type WriteOffLoanRequest struct {
+	LoanId  uint64 `json:"loan_id" validate:"required,gte=1"`  // required
+	AdminId uint64 `json:"-"`                                  // comes from auth context
+	RoleId  uint8  `json:"-"`                                  // comes from auth context
+	Reason  string `json:"reason" validate:"required,max=500"` // required
+}
+
+type WriteOffLoanResponse struct {
+	LoanId                uint64       `json:"loan_id"`
+	State                 string       `json:"state"` // WRITTEN_OFF
+	Currency              string       `json:"currency"`
+	OutstandingPrincipal  money.Amount `json:"outstanding_principal"`
+	OutstandingInterest   money.Amount `json:"outstanding_interest"`
+	OutstandingFee        money.Amount `json:"outstanding_fee"`
+	LossAmount            money.Amount `json:"loss_amount"` // outstanding principal spread across investors
+	AffectedInvestorCount uint32       `json:"affected_investor_count"`
+	WriteOffDate          time.Time    `json:"write_off_date"`
+}
+
+// -------------------- Prepay Loan --------------------
+
+type PrepayLoanRequest struct {
+	LoanId    uint64       `json:"loan_id" validate:"required,gte=1"`                                                            // required
+	PayerId   uint64       `json:"-"`                                                                                            // comes from auth context
+	RoleId    uint8        `json:"-"`                                                                                            // comes from auth context
+	Type      string       `json:"type" validate:"required,oneof=FULL PARTIAL"`                                                  // required
+	Strategy  string       `json:"strategy" validate:"required_if=Type PARTIAL,omitempty,oneof=REDUCE_TENOR REDUCE_INSTALLMENT"` // required for PARTIAL
+	Amount    money.Amount `json:"amount" validate:"required,numeric,gt=0"`                                                      // required, at least the payoff amount for FULL
+	Reference string       `json:"reference" validate:"max=100"`                                                                 // optional, e.g. bank transfer reference
+}
+
+type PrepayLoanResponse struct {
+	LoanId                uint64       `json:"loan_id"`
+	RepaymentId           uint64       `json:"repayment_id"`
+	Type                  string       `json:"type"`
+	Strategy              string       `json:"strategy"`
+	State                 string       `json:"state"` // CLOSED for FULL, REPAYING for PARTIAL
+	Currency              string       `json:"currency"`
+	AppliedFee            money.Amount `json:"applied_fee"`
+	AppliedInterest       money.Amount `json:"applied_interest"`
+	AppliedPrincipal      money.Amount `json:"applied_principal"`
+	ExcessAmount          money.Amount `json:"excess_amount"`
+	OutstandingPrincipal  money.Amount `json:"outstanding_principal"`
+	TenorMonths           uint32       `json:"tenor_months"`
+	NextInstallmentAmount money.Amount `json:"next_installment_amount"`
+	PaymentDate           time.Time    `json:"payment_date"`
+}
+
+// -------------------- Payoff Quote --------------------
+
+type GetPayoffQuoteRequest struct {
+	LoanId uint64 `json:"loan_id" validate:"required,gte=1"`              // required
+	UserId uint64 `json:"-"`                                              // comes from auth context
+	RoleId uint8  `json:"-"`                                              // comes from auth context
+	AsOf   string `json:"as_of" validate:"omitempty,datetime=2006-01-02"` // optional, defaults to today
+}
+
+type GetPayoffQuoteResponse struct {
+	LoanId               uint64       `json:"loan_id"`
+	AsOf                 time.Time    `json:"as_of"`
+	Currency             string       `json:"currency"`
+	OutstandingPrincipal money.Amount `json:"outstanding_principal"`
+	AccruedInterest      money.Amount `json:"accrued_interest"` // unpaid interest of due installments plus interest accrued on the current one
+	OutstandingFee       money.Amount `json:"outstanding_fee"`
+	PayoffAmount         money.Amount `json:"payoff_amount"`
+}
+
+// -------------------- Restructure Loan --------------------
+
+type RestructureLoanRequest struct {
+	LoanId            uint64   `json:"loan_id" validate:"required,gte=1"`                // required
+	AdminId           uint64   `json:"-"`                                                // comes from auth context
+	RoleId            uint8    `json:"-"`                                                // comes from auth context
+	ExtendTenorMonths uint32   `json:"extend_tenor_months" validate:"lte=60"`            // optional, months added to the remaining schedule
+	InterestRate      *float64 `json:"interest_rate" validate:"omitempty,gte=0,lte=100"` // optional, new annual interest rate
+	GracePeriodMonths uint32   `json:"grace_period_months" validate:"lte=12"`            // optional, interest-only months before amortization resumes
+	Reason            string   `json:"reason" validate:"required,max=500"`               // required
+}
+
+type RestructureLoanResponse struct {
+	RestructureId     uint64    `json:"restructure_id"`
+	LoanId            uint64    `json:"loan_id"`
+	Status            string    `json:"status"` // PENDING
+	ExtendTenorMonths uint32    `json:"extend_tenor_months"`
+	GracePeriodMonths uint32    `json:"grace_period_months"`
+	InterestRate      float64   `json:"interest_rate"`
+	TenorMonths       uint32    `json:"tenor_months"`
+	RequestedAt       time.Time `json:"requested_at"`
+}
+
+type ReviewLoanRestructureRequest struct {
+	RestructureId uint64 `json:"restructure_id" validate:"required,gte=1"`             // required
+	ReviewerId    uint64 `json:"-"`                                                    // comes from auth context
+	RoleId        uint8  `json:"-"`                                                    // comes from auth context
+	Decision      string `json:"decision" validate:"required,oneof=APPROVED REJECTED"` // required
+	Note          string `json:"note" validate:"max=500"`                              // optional
+}
+
+type ReviewLoanRestructureResponse struct {
+	RestructureId     uint64       `json:"restructure_id"`
+	LoanId            uint64       `json:"loan_id"`
+	Status            string       `json:"status"` // APPROVED or REJECTED
+	LoanState         string       `json:"loan_state"`
+	InterestRate      float64      `json:"interest_rate"`
+	TenorMonths       uint32       `json:"tenor_months"`
+	Currency          string       `json:"currency"`
+	CapitalizedAmount money.Amount `json:"capitalized_amount"` // arrears added to the principal
+	InstallmentAmount money.Amount `json:"installment_amount"` // installment after the grace period
+	ReviewedAt        time.Time    `json:"reviewed_at"`
+}
@@ -1,6 +1,24 @@
 package model
 
+import (
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/money"
+)
+
 type Message struct {
 	Topic   string `json:"topic"`
 	Payload any    `json:"payload"`
 }
+
+// InvestorPayoutEvent is published once per investor for every repayment distributed to them.
+type InvestorPayoutEvent struct {
+	LoanId          uint64       `json:"loan_id"`
+	RepaymentId     uint64       `json:"repayment_id"`
+	InvestorId      uint64       `json:"investor_id"`
+	Currency        string       `json:"currency"`
+	PrincipalAmount money.Amount `json:"principal_amount"`
+	ReturnAmount    money.Amount `json:"return_amount"`
+	Amount          money.Amount `json:"amount"`
+	PaidAt          time.Time    `json:"paid_at"`
+}
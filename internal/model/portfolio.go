@@ -0,0 +1,64 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/money"
+)
+
+// PortfolioHolding is an active investment of an investor along with the figures of its loan.
+type PortfolioHolding struct {
+	InvestmentId         uint64             `json:"investment_id" db:"investment_id"`
+	LoanId               uint64             `json:"loan_id" db:"loan_id"`
+	LoanState            constant.LoanState `json:"loan_state" db:"loan_state"`
+	Currency             string             `json:"currency" db:"currency"`
+	InvestedAmount       money.Amount       `json:"invested_amount" db:"invested_amount"`
+	InvestedAt           time.Time          `json:"invested_at" db:"invested_at"`
+	OwnershipPercentage  float64            `json:"ownership_percentage" db:"ownership_percentage"` // share of the loan principal
+	ROI                  float64            `json:"roi" db:"roi"`
+	ExpectedReturn       money.Amount       `json:"expected_return" db:"expected_return"` // share of the investor return on the loan interest
+	ReceivedPrincipal    money.Amount       `json:"received_principal" db:"received_principal"`
+	ReceivedReturn       money.Amount       `json:"received_return" db:"received_return"`
+	OutstandingPrincipal money.Amount       `json:"outstanding_principal" db:"outstanding_principal"` // share of the loan principal not repaid yet
+}
+
+// PortfolioFigures aggregates the holdings of a portfolio.
+type PortfolioFigures struct {
+	Positions            uint32       `json:"positions" db:"positions"`
+	InvestedAmount       money.Amount `json:"invested_amount" db:"invested_amount"`
+	ExpectedReturn       money.Amount `json:"expected_return" db:"expected_return"`
+	ReceivedPrincipal    money.Amount `json:"received_principal" db:"received_principal"`
+	ReceivedReturn       money.Amount `json:"received_return" db:"received_return"`
+	OutstandingPrincipal money.Amount `json:"outstanding_principal" db:"outstanding_principal"`
+}
+
+// PortfolioStateSummary aggregates the holdings of a portfolio in a currency whose loans are in a given state.
+type PortfolioStateSummary struct {
+	PortfolioFigures
+
+	Currency  string             `json:"currency" db:"currency"`
+	LoanState constant.LoanState `json:"loan_state" db:"loan_state"`
+}
+
+// PortfolioTotal aggregates the holdings of a portfolio in a currency, amounts in different currencies never being summed.
+type PortfolioTotal struct {
+	PortfolioFigures
+
+	Currency string                   `json:"currency"`
+	States   []*PortfolioStateSummary `json:"states"`
+}
+
+// -------------------- Get Portfolio --------------------
+
+type GetPortfolioRequest struct {
+	InvestorId uint64 `json:"-"`                        // comes from auth context
+	Limit      uint32 `json:"limit" validate:"lte=500"` // optional, defaults to 100
+	Cursor     string `json:"cursor"`                   // optional, next_cursor of the previous page
+}
+
+type GetPortfolioResponse struct {
+	Totals     []*PortfolioTotal   `json:"totals"`
+	Holdings   []*PortfolioHolding `json:"holdings"`
+	NextCursor string              `json:"next_cursor"` // empty on the last page
+}
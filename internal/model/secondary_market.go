@@ -0,0 +1,95 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/money"
+)
+
+type InvestmentListing struct {
+	CommonModel
+
+	InvestmentId uint64                           `json:"investment_id" db:"investment_id"`
+	LoanId       uint64                           `json:"loan_id" db:"loan_id"`
+	SellerId     uint64                           `json:"seller_id" db:"seller_id"`
+	Amount       money.Amount                     `json:"amount" db:"amount"` // principal of the position offered
+	Price        money.Amount                     `json:"price" db:"price"`
+	Currency     string                           `json:"currency" db:"currency"`
+	Status       constant.InvestmentListingStatus `json:"status" db:"status"`
+	ClosedAt     sql.NullTime                     `json:"closed_at" db:"closed_at"` // set once sold or cancelled
+}
+
+type InvestmentTransfer struct {
+	CommonModel
+
+	ListingId             uint64        `json:"listing_id" db:"listing_id"`
+	LoanId                uint64        `json:"loan_id" db:"loan_id"`
+	SellerId              uint64        `json:"seller_id" db:"seller_id"`
+	BuyerId               uint64        `json:"buyer_id" db:"buyer_id"`
+	SourceInvestmentId    uint64        `json:"source_investment_id" db:"source_investment_id"`
+	BuyerInvestmentId     uint64        `json:"buyer_investment_id" db:"buyer_investment_id"`
+	RemainderInvestmentId sql.NullInt64 `json:"remainder_investment_id" db:"remainder_investment_id"` // null when the whole position was sold
+	Amount                money.Amount  `json:"amount" db:"amount"`
+	Price                 money.Amount  `json:"price" db:"price"`
+	Currency              string        `json:"currency" db:"currency"`
+	TransferredAt         time.Time     `json:"transferred_at" db:"transferred_at"`
+}
+
+// -------------------- Create Investment Listing --------------------
+
+type CreateInvestmentListingRequest struct {
+	InvestmentId uint64       `json:"investment_id" validate:"required,gte=1"` // required
+	SellerId     uint64       `json:"-"`                                       // comes from auth context
+	Amount       money.Amount `json:"amount" validate:"required,numeric,gt=0"` // required, at most the principal of the investment
+	Price        money.Amount `json:"price" validate:"required,numeric,gt=0"`  // required, in the currency of the loan
+}
+
+type CreateInvestmentListingResponse struct {
+	Listing *InvestmentListing `json:"listing"`
+}
+
+// -------------------- Cancel Investment Listing --------------------
+
+type CancelInvestmentListingRequest struct {
+	ListingId uint64 `json:"listing_id" validate:"required,gte=1"` // required
+	SellerId  uint64 `json:"-"`                                    // comes from auth context
+}
+
+type CancelInvestmentListingResponse struct {
+	Listing *InvestmentListing `json:"listing"`
+}
+
+// -------------------- Buy Investment Listing --------------------
+
+type BuyInvestmentListingRequest struct {
+	ListingId uint64 `json:"listing_id" validate:"required,gte=1"` // required
+	BuyerId   uint64 `json:"-"`                                    // comes from auth context
+}
+
+type BuyInvestmentListingResponse struct {
+	Transfer *InvestmentTransfer `json:"transfer"`
+}
+
+// -------------------- List Investment Listings --------------------
+
+type ListInvestmentListingsRequest struct {
+	LoanId uint64 `json:"loan_id"`                                               // optional, every loan if empty
+	Status string `json:"status" validate:"omitempty,oneof=OPEN SOLD CANCELLED"` // optional, defaults to OPEN
+}
+
+type ListInvestmentListingsResponse struct {
+	Listings []*InvestmentListing `json:"listings"`
+}
+
+// -------------------- List Investment Transfers --------------------
+
+type ListInvestmentTransfersRequest struct {
+	LoanId     uint64 `json:"loan_id"` // optional, every loan if empty
+	InvestorId uint64 `json:"-"`       // comes from auth context, zero for admins who see every transfer
+}
+
+type ListInvestmentTransfersResponse struct {
+	Transfers []*InvestmentTransfer `json:"transfers"`
+}
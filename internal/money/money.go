@@ -0,0 +1,206 @@
+// Package money provides an exact monetary amount type.
+// Amounts are held as an integer number of cents, the precision of the NUMERIC(15,2) columns they are stored in,
+// so adding, subtracting and comparing them never drifts. Scaling by a rate is done with exact rationals
+// and rounded to cents half away from zero.
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Amount is an exact monetary amount in cents.
+type Amount int64
+
+// Unit is one whole currency unit.
+const Unit Amount = 100
+
+// Scale is the number of decimal places of an amount.
+const Scale = 2
+
+var (
+	ErrMalformedAmount = errors.New("malformed amount")
+	ErrAmountPrecision = errors.New("amount has more than 2 decimal places")
+)
+
+// Parse parses a decimal string such as "1500", "1500.5" or "-1500.25".
+func Parse(s string) (a Amount, err error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" {
+		return 0, ErrMalformedAmount
+	}
+	if len(frac) > Scale {
+		return 0, ErrAmountPrecision
+	}
+	frac += strings.Repeat("0", Scale-len(frac))
+	if whole == "" {
+		whole = "0"
+	}
+
+	units, err := strconv.ParseUint(whole, 10, 63)
+	if err != nil {
+		return 0, ErrMalformedAmount
+	}
+	cents, err := strconv.ParseUint(frac, 10, 63)
+	if err != nil {
+		return 0, ErrMalformedAmount
+	}
+	if units > math.MaxInt64/uint64(Unit) || units == math.MaxInt64/uint64(Unit) && cents > math.MaxInt64%uint64(Unit) {
+		return 0, ErrMalformedAmount
+	}
+
+	a = Amount(units)*Unit + Amount(cents)
+	if negative {
+		a = -a
+	}
+	return a, nil
+}
+
+// FromFloat converts a float to the nearest amount. Only meant for configuration values, never for arithmetic.
+func FromFloat(f float64) Amount {
+	return Amount(math.Round(f * float64(Unit)))
+}
+
+// FromRat rounds a rational number of currency units to cents, half away from zero.
+func FromRat(r *big.Rat) Amount {
+	scaled := new(big.Rat).Mul(r, big.NewRat(int64(Unit), 1))
+	quo, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+
+	// Round half away from zero: |2*rem| >= denom.
+	if new(big.Int).Abs(new(big.Int).Lsh(rem, 1)).Cmp(scaled.Denom()) >= 0 {
+		quo.Add(quo, big.NewInt(int64(scaled.Num().Sign())))
+	}
+	return Amount(quo.Int64())
+}
+
+// Rat returns the amount as an exact rational number of currency units.
+func (a Amount) Rat() *big.Rat {
+	return big.NewRat(int64(a), int64(Unit))
+}
+
+// Mul scales the amount by r and rounds the result to cents.
+func (a Amount) Mul(r *big.Rat) Amount {
+	return FromRat(new(big.Rat).Mul(a.Rat(), r))
+}
+
+// Float64 returns the nearest float, for display and validation bounds only.
+func (a Amount) Float64() float64 {
+	return float64(a) / float64(Unit)
+}
+
+// String formats the amount with exactly two decimal places, e.g. "1500.00".
+func (a Amount) String() string {
+	sign := ""
+	cents := uint64(a)
+	if a < 0 {
+		sign = "-"
+		cents = uint64(-a)
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/uint64(Unit), cents%uint64(Unit))
+}
+
+// Split splits the amount in proportion to weights using the largest remainder method:
+// every share is floored to cents and the leftover cents go to the largest remainders, ties broken by order.
+// The returned shares always sum exactly to the amount. Zero or negative weights get nothing.
+func (a Amount) Split(weights []Amount) []Amount {
+	shares := make([]Amount, len(weights))
+	weightSum := new(big.Int)
+	for _, weight := range weights {
+		if weight > 0 {
+			weightSum.Add(weightSum, big.NewInt(int64(weight)))
+		}
+	}
+	if weightSum.Sign() == 0 || a <= 0 {
+		return shares
+	}
+
+	type part struct {
+		index     int
+		remainder *big.Int
+	}
+	parts := make([]part, 0, len(weights))
+	leftover := a
+	total := big.NewInt(int64(a))
+	for i, weight := range weights {
+		if weight <= 0 {
+			continue
+		}
+		quo, rem := new(big.Int).QuoRem(new(big.Int).Mul(total, big.NewInt(int64(weight))), weightSum, new(big.Int))
+		shares[i] = Amount(quo.Int64())
+		leftover -= shares[i]
+		parts = append(parts, part{index: i, remainder: rem})
+	}
+
+	sort.SliceStable(parts, func(i, j int) bool {
+		return parts[i].remainder.Cmp(parts[j].remainder) > 0
+	})
+	for i := 0; leftover > 0; i, leftover = i+1, leftover-1 {
+		shares[parts[i%len(parts)].index]++
+	}
+
+	return shares
+}
+
+// Scan implements sql.Scanner for NUMERIC columns.
+func (a *Amount) Scan(src any) (err error) {
+	switch v := src.(type) {
+	case nil:
+		*a = 0
+	case []byte:
+		*a, err = Parse(string(v))
+	case string:
+		*a, err = Parse(v)
+	case int64:
+		*a = Amount(v) * Unit
+	case float64:
+		*a = FromFloat(v)
+	default:
+		err = fmt.Errorf("money: cannot scan %T", src)
+	}
+	return
+}
+
+// Value implements driver.Valuer, amounts are written as exact decimal strings.
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// MarshalJSON writes the amount as a decimal string so no JSON client parses it into a float.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON accepts both a decimal string and a JSON number.
+func (a *Amount) UnmarshalJSON(b []byte) (err error) {
+	var s string
+	if err = json.Unmarshal(b, &s); err != nil {
+		var n json.Number
+		if err = json.Unmarshal(b, &n); err != nil {
+			return ErrMalformedAmount
+		}
+		s = n.String()
+	}
+	if s == "" {
+		*a = 0
+		return nil
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return
+	}
+
+	*a = parsed
+	return
+}
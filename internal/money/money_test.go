@@ -0,0 +1,96 @@
+package money
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tm := []struct {
+		name    string
+		in      string
+		want    Amount
+		wantErr error
+	}{
+		{name: "whole", in: "1500", want: 150_000},
+		{name: "oneDecimal", in: "1500.5", want: 150_050},
+		{name: "twoDecimals", in: "0.07", want: 7},
+		{name: "negative", in: "-12.34", want: -1_234},
+		{name: "noWhole", in: ".5", want: 50},
+		{name: "tooPrecise", in: "1.005", wantErr: ErrAmountPrecision},
+		{name: "empty", in: "", wantErr: ErrMalformedAmount},
+		{name: "garbage", in: "12a.00", wantErr: ErrMalformedAmount},
+		{name: "largest", in: "92233720368547758.07", want: math.MaxInt64},
+		{name: "overflowCents", in: "92233720368547758.99", wantErr: ErrMalformedAmount},
+		{name: "overflowUnits", in: "92233720368547759", wantErr: ErrMalformedAmount},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "1500.00", Amount(150_000).String())
+	assert.Equal(t, "0.07", Amount(7).String())
+	assert.Equal(t, "-12.34", Amount(-1_234).String())
+}
+
+func TestMul(t *testing.T) {
+	assert.Equal(t, Amount(1), Amount(10).Mul(big.NewRat(1, 20)))   // 0.005 rounds up.
+	assert.Equal(t, Amount(-1), Amount(-10).Mul(big.NewRat(1, 20))) // Half away from zero.
+	assert.Equal(t, Amount(0), Amount(10).Mul(big.NewRat(1, 21)))
+	assert.Equal(t, Amount(100_000), Amount(1_200_000).Mul(big.NewRat(1, 12)))
+}
+
+func TestSplit(t *testing.T) {
+	shares := Amount(100).Split([]Amount{1, 1, 1})
+	assert.Equal(t, []Amount{34, 33, 33}, shares)
+
+	shares = Amount(1_000).Split([]Amount{0, 300, 700})
+	assert.Equal(t, []Amount{0, 300, 700}, shares)
+
+	assert.Equal(t, []Amount{0, 0}, Amount(1_000).Split([]Amount{0, 0}))
+}
+
+func TestJSON(t *testing.T) {
+	var v struct {
+		Amount Amount `json:"amount"`
+	}
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount":"1500.25"}`), &v))
+	assert.Equal(t, Amount(150_025), v.Amount)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount":1500.5}`), &v))
+	assert.Equal(t, Amount(150_050), v.Amount)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":"abc"}`), &v))
+
+	b, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"amount":"1500.50"}`, string(b))
+}
+
+func TestScan(t *testing.T) {
+	var a Amount
+	assert.NoError(t, a.Scan([]byte("99.90")))
+	assert.Equal(t, Amount(9_990), a)
+
+	assert.NoError(t, a.Scan(int64(5)))
+	assert.Equal(t, Amount(500), a)
+
+	assert.Error(t, a.Scan(true))
+
+	v, err := Amount(9_990).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "99.90", v)
+}
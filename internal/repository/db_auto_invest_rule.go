@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateAutoInvestRule inserts a new auto invest rule.
+func (r *dbRepository) CreateAutoInvestRule(ctx context.Context, rule *model.AutoInvestRule, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO auto_invest_rule (
+		investor_id,
+		currency,
+		amount_per_loan,
+		min_roi,
+		max_principal,
+		max_tenor_months,
+		max_risk_grade,
+		created_by,
+		updated_by
+	) VALUES (
+		:investor_id,
+		:currency,
+		:amount_per_loan,
+		:min_roi,
+		:max_principal,
+		:max_tenor_months,
+		:max_risk_grade,
+		:created_by,
+		:updated_by
+	)
+	RETURNING id
+	`
+
+	query, args, err := tx.BindNamed(query, rule)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, query, args...).Scan(&rule.Id)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetAutoInvestRuleById retrieves an auto invest rule by its ID, deleted rules are not found.
+func (r *dbRepository) GetAutoInvestRuleById(ctx context.Context, ruleId uint64, tx *sqlx.Tx) (rule *model.AutoInvestRule, err error) {
+	rule = &model.AutoInvestRule{}
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return nil, err
+	}
+
+	query := `
+	SELECT *
+	FROM auto_invest_rule
+	WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	err = tx.GetContext(ctx, rule, query, ruleId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, constant.ErrAutoInvestRuleNotFound
+		}
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetAutoInvestRulesByInvestorId retrieves the rules of an investor, oldest first.
+func (r *dbRepository) GetAutoInvestRulesByInvestorId(ctx context.Context, investorId uint64, tx *sqlx.Tx) (rules []*model.AutoInvestRule, err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT *
+	FROM auto_invest_rule
+	WHERE investor_id = $1 AND deleted_at IS NULL
+	ORDER BY id
+	`
+
+	if err = tx.SelectContext(ctx, &rules, query, investorId); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetAutoInvestRulesByCurrency retrieves the rules in a currency in round-robin order,
+// rules that never invested first, then the ones that invested the longest ago.
+func (r *dbRepository) GetAutoInvestRulesByCurrency(ctx context.Context, currency string, tx *sqlx.Tx) (rules []*model.AutoInvestRule, err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT *
+	FROM auto_invest_rule
+	WHERE currency = $1 AND deleted_at IS NULL
+	ORDER BY last_invested_at ASC NULLS FIRST, id
+	`
+
+	if err = tx.SelectContext(ctx, &rules, query, currency); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// SetAutoInvestRuleInvestedAt records when a rule last invested, moving it to the back of the round-robin.
+func (r *dbRepository) SetAutoInvestRuleInvestedAt(ctx context.Context, ruleId uint64, investedAt time.Time, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	UPDATE auto_invest_rule
+	SET
+		last_invested_at = $2,
+		updated_at       = NOW()
+	WHERE id = $1
+	`
+
+	if _, err = tx.ExecContext(ctx, query, ruleId, investedAt); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// DeleteAutoInvestRule soft deletes an auto invest rule.
+func (r *dbRepository) DeleteAutoInvestRule(ctx context.Context, rule *model.AutoInvestRule, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	UPDATE auto_invest_rule
+	SET
+		deleted_at = :deleted_at,
+		deleted_by = :deleted_by,
+		updated_at = NOW(),
+		updated_by = :deleted_by
+	WHERE id = :id
+	`
+
+	query, args, err := tx.BindNamed(query, rule)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
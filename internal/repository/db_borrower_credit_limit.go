@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// GetBorrowerCreditLimit returns the credit limit of a borrower in a currency, or nil if none was set.
+func (r *dbRepository) GetBorrowerCreditLimit(ctx context.Context, borrowerId uint64, currency string, tx *sqlx.Tx) (limit *model.BorrowerCreditLimit, err error) {
+	limit = &model.BorrowerCreditLimit{}
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return nil, err
+	}
+
+	query := `
+	SELECT *
+	FROM borrower_credit_limit
+	WHERE borrower_id = $1 AND currency = $2
+	`
+
+	err = tx.GetContext(ctx, limit, query, borrowerId, currency)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// UpsertBorrowerCreditLimit sets the credit limit of a borrower in a currency.
+func (r *dbRepository) UpsertBorrowerCreditLimit(ctx context.Context, limit *model.BorrowerCreditLimit, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO borrower_credit_limit (
+		borrower_id,
+		currency,
+		limit_amount,
+		created_by,
+		updated_by
+	) VALUES (
+		:borrower_id,
+		:currency,
+		:limit_amount,
+		:created_by,
+		:updated_by
+	)
+	ON CONFLICT (borrower_id, currency) DO UPDATE SET
+		limit_amount = EXCLUDED.limit_amount,
+		updated_at   = NOW(),
+		updated_by   = EXCLUDED.updated_by
+	RETURNING id
+	`
+
+	query, args, err := tx.BindNamed(query, limit)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, query, args...).Scan(&limit.Id)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// CreateBorrowerCreditLimitAudit records a change of a borrower credit limit.
+func (r *dbRepository) CreateBorrowerCreditLimitAudit(ctx context.Context, audit *model.BorrowerCreditLimitAudit, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO borrower_credit_limit_audit (
+		borrower_id,
+		currency,
+		previous_limit,
+		new_limit,
+		reason,
+		changed_by,
+		created_by
+	) VALUES (
+		:borrower_id,
+		:currency,
+		:previous_limit,
+		:new_limit,
+		:reason,
+		:changed_by,
+		:created_by
+	)
+	RETURNING id
+	`
+
+	query, args, err := tx.BindNamed(query, audit)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, query, args...).Scan(&audit.Id)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetBorrowerExposure sums the principal of the loans of a borrower in a currency, except loans in the given states.
+func (r *dbRepository) GetBorrowerExposure(ctx context.Context, borrowerId uint64, currency string, excludedStates []constant.LoanState, tx *sqlx.Tx) (exposure money.Amount, err error) {
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT COALESCE(SUM(principal_amount), 0)
+	FROM loan
+	WHERE borrower_id = $1 AND currency = $2 AND state <> ALL($3) AND deleted_at IS NULL
+	`
+
+	values := make([]string, 0, len(excludedStates))
+	for _, state := range excludedStates {
+		values = append(values, string(state))
+	}
+	if err = tx.QueryRowxContext(ctx, query, borrowerId, currency, pq.Array(values)).Scan(&exposure); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateInvestmentListing inserts a new listing on the secondary market.
+func (r *dbRepository) CreateInvestmentListing(ctx context.Context, listing *model.InvestmentListing, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO investment_listing (
+		investment_id,
+		loan_id,
+		seller_id,
+		amount,
+		price,
+		currency,
+		status,
+		created_by,
+		updated_by
+	) VALUES (
+		:investment_id,
+		:loan_id,
+		:seller_id,
+		:amount,
+		:price,
+		:currency,
+		:status,
+		:created_by,
+		:updated_by
+	)
+	RETURNING id
+	`
+
+	query, args, err := tx.BindNamed(query, listing)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, query, args...).Scan(&listing.Id)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetInvestmentListingById retrieves a listing by its ID.
+func (r *dbRepository) GetInvestmentListingById(ctx context.Context, listingId uint64, tx *sqlx.Tx) (listing *model.InvestmentListing, err error) {
+	listing = &model.InvestmentListing{}
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return nil, err
+	}
+
+	query := `
+	SELECT *
+	FROM investment_listing
+	WHERE id = $1
+	`
+
+	err = tx.GetContext(ctx, listing, query, listingId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, constant.ErrListingNotFound
+		}
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// HasOpenInvestmentListing checks whether an investment is currently offered on the secondary market.
+func (r *dbRepository) HasOpenInvestmentListing(ctx context.Context, investmentId uint64, tx *sqlx.Tx) (isListed bool, err error) {
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT EXISTS (
+		SELECT 1
+		FROM investment_listing
+		WHERE investment_id = $1 AND status = $2
+	)
+	`
+
+	if err = tx.QueryRowxContext(ctx, query, investmentId, constant.InvestmentListingStatusOpen).Scan(&isListed); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// UpdateInvestmentListing updates the status of a listing and when it was closed.
+func (r *dbRepository) UpdateInvestmentListing(ctx context.Context, listing *model.InvestmentListing, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	UPDATE investment_listing
+	SET
+		status     = :status,
+		closed_at  = :closed_at,
+		updated_at = NOW(),
+		updated_by = :updated_by
+	WHERE id = :id
+	`
+
+	query, args, err := tx.BindNamed(query, listing)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetInvestmentListings retrieves the listings in a status, newest first. A zero loanId lists the listings of every loan.
+func (r *dbRepository) GetInvestmentListings(ctx context.Context, loanId uint64, status constant.InvestmentListingStatus) (listings []*model.InvestmentListing, err error) {
+	query := `
+	SELECT *
+	FROM investment_listing
+	WHERE ($1 = 0 OR loan_id = $1) AND status = $2
+	ORDER BY id DESC
+	`
+
+	if err = r.db.SelectContext(ctx, &listings, query, loanId, status); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
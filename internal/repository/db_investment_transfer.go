@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateInvestmentTransfer records a sale on the secondary market.
+func (r *dbRepository) CreateInvestmentTransfer(ctx context.Context, transfer *model.InvestmentTransfer, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO investment_transfer (
+		listing_id,
+		loan_id,
+		seller_id,
+		buyer_id,
+		source_investment_id,
+		buyer_investment_id,
+		remainder_investment_id,
+		amount,
+		price,
+		currency,
+		transferred_at,
+		created_by
+	) VALUES (
+		:listing_id,
+		:loan_id,
+		:seller_id,
+		:buyer_id,
+		:source_investment_id,
+		:buyer_investment_id,
+		:remainder_investment_id,
+		:amount,
+		:price,
+		:currency,
+		:transferred_at,
+		:created_by
+	)
+	RETURNING id
+	`
+
+	query, args, err := tx.BindNamed(query, transfer)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, query, args...).Scan(&transfer.Id)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetInvestmentTransfers retrieves the transfers of a loan, newest first.
+// A zero loanId lists the transfers of every loan, a non-zero investorId only the ones it bought or sold.
+func (r *dbRepository) GetInvestmentTransfers(ctx context.Context, loanId, investorId uint64) (transfers []*model.InvestmentTransfer, err error) {
+	query := `
+	SELECT *
+	FROM investment_transfer
+	WHERE ($1 = 0 OR loan_id = $1) AND ($2 = 0 OR seller_id = $2 OR buyer_id = $2)
+	ORDER BY id DESC
+	`
+
+	if err = r.db.SelectContext(ctx, &transfers, query, loanId, investorId); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateInvestorPayouts inserts the payouts of a repayment in a single statement.
+func (r *dbRepository) CreateInvestorPayouts(ctx context.Context, payouts []*model.InvestorPayout, tx *sqlx.Tx) (err error) {
+	if len(payouts) == 0 {
+		return
+	}
+
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO investor_payout (
+		loan_id,
+		repayment_id,
+		investment_id,
+		investor_id,
+		principal_amount,
+		return_amount,
+		amount,
+		paid_at,
+		created_by,
+		updated_by
+	) VALUES (
+		:loan_id,
+		:repayment_id,
+		:investment_id,
+		:investor_id,
+		:principal_amount,
+		:return_amount,
+		:amount,
+		:paid_at,
+		:created_by,
+		:updated_by
+	)
+	`
+
+	_, err = tx.NamedExecContext(ctx, query, payouts)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
@@ -2,10 +2,15 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"time"
 
+	"github.com/ffauzann/loan-service/internal/constant"
 	"github.com/ffauzann/loan-service/internal/model"
 	"github.com/ffauzann/loan-service/internal/util"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 // CreateLoan inserts a new loan record into the database.
@@ -24,22 +29,28 @@ func (r *dbRepository) CreateLoan(ctx context.Context, loan *model.Loan, tx *sql
 	INSERT INTO loan (
 		borrower_id, 
 		principal_amount, 
+		currency,
 		interest_rate, 
 		roi, 
 		agreement_link, 
 		state, 
 		invested_amount, 
+		funding_deadline,
+		tenor_months,
 		created_by,
 		updated_by
 	)
 	VALUES (
 		:borrower_id, 
 		:principal_amount, 
+		:currency,
 		:interest_rate, 
 		:roi, 
 		:agreement_link, 
 		:state, 
 		:invested_amount, 
+		:funding_deadline,
+		:tenor_months,
 		:created_by,
 		:updated_by
 	)
@@ -78,6 +89,9 @@ func (r *dbRepository) GetLoanById(ctx context.Context, loanID uint64, tx *sqlx.
 
 	err = tx.GetContext(ctx, loan, query, loanID)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, constant.ErrLoanNotFound
+		}
 		util.LogContext(ctx).Error(err.Error())
 		return
 	}
@@ -100,15 +114,23 @@ func (r *dbRepository) UpdateLoan(ctx context.Context, loan *model.Loan, tx *sql
 	query := `
 	UPDATE loan
 	SET
-		borrower_id       = :borrower_id,
-		principal_amount  = :principal_amount,
-		interest_rate     = :interest_rate,
-		roi               = :roi,
-		agreement_link    = :agreement_link,
-		state             = :state,
-		invested_amount   = :invested_amount,
-		updated_at        = NOW(),
-		updated_by        = :updated_by
+		borrower_id            = :borrower_id,
+		principal_amount       = :principal_amount,
+		interest_rate          = :interest_rate,
+		roi                    = :roi,
+		agreement_link         = :agreement_link,
+		state                  = :state,
+		invested_amount        = :invested_amount,
+		funding_deadline       = :funding_deadline,
+		tenor_months           = :tenor_months,
+		risk_grade             = :risk_grade,
+		days_past_due          = :days_past_due,
+		delinquency_bucket     = :delinquency_bucket,
+		delinquency_checked_at = :delinquency_checked_at,
+		first_missed_at        = :first_missed_at,
+		accrual_end_date       = :accrual_end_date,
+		updated_at             = NOW(),
+		updated_by             = :updated_by
 	WHERE id = :id
 	`
 
@@ -126,3 +148,116 @@ func (r *dbRepository) UpdateLoan(ctx context.Context, loan *model.Loan, tx *sql
 
 	return
 }
+
+// GetOverdueLoanIds returns the IDs of loans still open for funding whose deadline has passed.
+func (r *dbRepository) GetOverdueLoanIds(ctx context.Context, deadline time.Time) (loanIds []uint64, err error) {
+	query := `
+	SELECT id
+	FROM loan
+	WHERE state = ANY($1) AND funding_deadline < $2 AND deleted_at IS NULL
+	ORDER BY funding_deadline
+	`
+
+	states := []string{string(constant.LoanStateApproved), string(constant.LoanStateFunding)}
+	if err = r.db.SelectContext(ctx, &loanIds, query, pq.Array(states), deadline); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetLoanIdsByStates returns the IDs of loans in any of the given states.
+func (r *dbRepository) GetLoanIdsByStates(ctx context.Context, states []constant.LoanState) (loanIds []uint64, err error) {
+	query := `
+	SELECT id
+	FROM loan
+	WHERE state = ANY($1) AND deleted_at IS NULL
+	ORDER BY id
+	`
+
+	values := make([]string, 0, len(states))
+	for _, state := range states {
+		values = append(values, string(state))
+	}
+	if err = r.db.SelectContext(ctx, &loanIds, query, pq.Array(values)); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// loanSortColumns maps every sortable field to its column and the type its cursor value is cast to.
+var loanSortColumns = map[constant.LoanSortField]struct{ column, cast string }{
+	constant.LoanSortFieldCreatedAt:       {"created_at", "timestamptz"},
+	constant.LoanSortFieldPrincipalAmount: {"principal_amount", "numeric"},
+	constant.LoanSortFieldInterestRate:    {"interest_rate", "numeric"},
+}
+
+// GetLoans returns a page of loans matching the given filter, ordered by the sort field then ID
+// and starting right after the (AfterValue, AfterId) keyset when AfterId is set.
+func (r *dbRepository) GetLoans(ctx context.Context, filter *model.LoanFilter) (loans []*model.Loan, err error) {
+	sort, ok := loanSortColumns[filter.SortBy]
+	if !ok {
+		return nil, constant.ErrInvalidLoanFilter
+	}
+
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	query := fmt.Sprintf(`
+	SELECT *
+	FROM loan
+	WHERE deleted_at IS NULL
+		AND (CARDINALITY($1::text[]) = 0 OR state::text = ANY($1::text[]))
+		AND ($2 = 0 OR borrower_id = $2)
+		AND ($3::numeric = 0 OR principal_amount >= $3::numeric)
+		AND ($4::numeric = 0 OR principal_amount <= $4::numeric)
+		AND ($5::timestamptz IS NULL OR created_at >= $5::timestamptz)
+		AND ($6::timestamptz IS NULL OR created_at < $6::timestamptz)
+		AND ($7 = 0 OR state::text = ANY($8::text[]) OR EXISTS (
+			SELECT 1
+			FROM loan_investment
+			WHERE loan_id = loan.id AND investor_id = $7 AND status = $9
+		))
+		AND ($10 = 0 OR (%[1]s, id) %[3]s ($11::%[2]s, $10))
+	ORDER BY %[1]s %[4]s, id %[4]s
+	LIMIT $12
+	`, sort.column, sort.cast, comparison, direction)
+
+	states := make([]string, 0, len(filter.States))
+	for _, state := range filter.States {
+		states = append(states, string(state))
+	}
+	marketStates := make([]string, 0, len(filter.MarketStates))
+	for _, state := range filter.MarketStates {
+		marketStates = append(marketStates, string(state))
+	}
+
+	// An empty cursor value cannot be cast, it is only compared once AfterId is set anyway.
+	afterValue := sql.NullString{String: filter.AfterValue, Valid: filter.AfterId != 0}
+
+	err = r.db.SelectContext(ctx, &loans, query,
+		pq.Array(states),
+		filter.BorrowerId,
+		filter.MinPrincipal,
+		filter.MaxPrincipal,
+		sql.NullTime{Time: filter.CreatedFrom, Valid: !filter.CreatedFrom.IsZero()},
+		sql.NullTime{Time: filter.CreatedTo, Valid: !filter.CreatedTo.IsZero()},
+		filter.InvestorId,
+		pq.Array(marketStates),
+		constant.LoanInvestmentStatusActive,
+		filter.AfterId,
+		afterValue,
+		filter.Limit,
+	)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateLoanAgreement records a generated loan agreement document.
+func (r *dbRepository) CreateLoanAgreement(ctx context.Context, agreement *model.LoanAgreement, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO loan_agreement (
+		loan_id,
+		type,
+		investor_id,
+		template_version,
+		document_link,
+		created_by
+	) VALUES (
+		:loan_id,
+		:type,
+		:investor_id,
+		:template_version,
+		:document_link,
+		:created_by
+	)
+	RETURNING id
+	`
+
+	query, args, err := tx.BindNamed(query, agreement)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, query, args...).Scan(&agreement.Id)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
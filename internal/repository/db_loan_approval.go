@@ -8,6 +8,7 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+// ApproveLoan inserts an approval step of a loan.
 func (r *dbRepository) ApproveLoan(ctx context.Context, approval *model.LoanApproval, tx *sqlx.Tx) (err error) {
 	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
 		defer func() { r.EndTx(ctx, tx, err) }()
@@ -23,12 +24,18 @@ func (r *dbRepository) ApproveLoan(ctx context.Context, approval *model.LoanAppr
 	INSERT INTO loan_approval (
 		loan_id,
 		validator_id,
+		role_id,
+		step,
+		required_steps,
 		photo_proof_link,
 		approval_date,
 		created_by
 	) VALUES (
 		:loan_id,
 		:validator_id,
+		:role_id,
+		:step,
+		:required_steps,
 		:photo_proof_link,
 		:approval_date,
 		:created_by
@@ -50,3 +57,27 @@ func (r *dbRepository) ApproveLoan(ctx context.Context, approval *model.LoanAppr
 
 	return
 }
+
+// GetLoanApprovalsByLoanId returns the approval steps done on a loan, in step order.
+func (r *dbRepository) GetLoanApprovalsByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) (approvals []*model.LoanApproval, err error) {
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT *
+	FROM loan_approval
+	WHERE loan_id = $1
+	ORDER BY step
+	`
+
+	if err = tx.SelectContext(ctx, &approvals, query, loanId); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// CreateLoanAssignment inserts a new assignment of a loan to a field validator.
+func (r *dbRepository) CreateLoanAssignment(ctx context.Context, assignment *model.LoanAssignment, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO loan_assignment (
+		loan_id,
+		validator_id,
+		status,
+		assigned_at,
+		created_by,
+		updated_by
+	) VALUES (
+		:loan_id,
+		:validator_id,
+		:status,
+		:assigned_at,
+		:created_by,
+		:updated_by
+	)
+	RETURNING id
+	`
+
+	query, args, err := tx.BindNamed(query, assignment)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, query, args...).Scan(&assignment.Id)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetOpenLoanAssignment retrieves the current assignment of a loan, nil if the loan is not assigned.
+func (r *dbRepository) GetOpenLoanAssignment(ctx context.Context, loanId uint64, tx *sqlx.Tx) (assignment *model.LoanAssignment, err error) {
+	assignment = &model.LoanAssignment{}
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return nil, err
+	}
+
+	query := `
+	SELECT *
+	FROM loan_assignment
+	WHERE loan_id = $1 AND status = $2
+	`
+
+	err = tx.GetContext(ctx, assignment, query, loanId, constant.LoanAssignmentStatusAssigned)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// UpdateLoanAssignment updates the status of an assignment and when it was closed.
+func (r *dbRepository) UpdateLoanAssignment(ctx context.Context, assignment *model.LoanAssignment, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	UPDATE loan_assignment
+	SET
+		status     = :status,
+		closed_at  = :closed_at,
+		updated_at = NOW(),
+		updated_by = :updated_by
+	WHERE id = :id
+	`
+
+	query, args, err := tx.BindNamed(query, assignment)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetValidatorWorkloads returns every active field validator, in ID order, along with the number of loans in the given
+// states assigned to them.
+func (r *dbRepository) GetValidatorWorkloads(ctx context.Context, states []constant.LoanState, tx *sqlx.Tx) (workloads []*model.ValidatorWorkload, err error) {
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT u.id AS validator_id, COUNT(l.id) AS open_assignments
+	FROM "user" u
+	LEFT JOIN loan_assignment la ON la.validator_id = u.id AND la.status = $3
+	LEFT JOIN loan l ON l.id = la.loan_id AND l.state = ANY($4) AND l.deleted_at IS NULL
+	WHERE u.role_id = $1 AND u.status = $2 AND u.deleted_at IS NULL
+	GROUP BY u.id
+	ORDER BY u.id
+	`
+
+	values := make([]string, 0, len(states))
+	for _, state := range states {
+		values = append(values, string(state))
+	}
+	err = tx.SelectContext(ctx, &workloads, query,
+		constant.RoleIdFieldValidator,
+		constant.UserStatusActive,
+		constant.LoanAssignmentStatusAssigned,
+		pq.Array(values),
+	)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetLastAssignedValidatorId returns the validator of the latest assignment, zero if no loan was ever assigned.
+func (r *dbRepository) GetLastAssignedValidatorId(ctx context.Context, tx *sqlx.Tx) (validatorId uint64, err error) {
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT COALESCE((
+		SELECT validator_id
+		FROM loan_assignment
+		ORDER BY id DESC
+		LIMIT 1
+	), 0)
+	`
+
+	if err = tx.QueryRowxContext(ctx, query).Scan(&validatorId); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetUnassignedLoans returns the loans in any of the given states without a current assignment along with their
+// approval count, oldest first.
+func (r *dbRepository) GetUnassignedLoans(ctx context.Context, states []constant.LoanState) (loans []*model.QueuedLoan, err error) {
+	query := `
+	SELECT
+		l.id AS loan_id,
+		l.currency,
+		l.principal_amount,
+		(SELECT COUNT(*) FROM loan_approval a WHERE a.loan_id = l.id) AS approval_count
+	FROM loan l
+	WHERE l.state = ANY($1) AND l.deleted_at IS NULL AND NOT EXISTS (
+		SELECT 1
+		FROM loan_assignment la
+		WHERE la.loan_id = l.id AND la.status = $2
+	)
+	ORDER BY l.id
+	`
+
+	values := make([]string, 0, len(states))
+	for _, state := range states {
+		values = append(values, string(state))
+	}
+	if err = r.db.SelectContext(ctx, &loans, query, pq.Array(values), constant.LoanAssignmentStatusAssigned); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetPendingAssignments returns the current assignments of a validator on loans in any of the given states, oldest first.
+func (r *dbRepository) GetPendingAssignments(ctx context.Context, validatorId uint64, states []constant.LoanState) (assignments []*model.PendingAssignment, err error) {
+	query := `
+	SELECT
+		la.id AS assignment_id,
+		la.loan_id,
+		l.borrower_id,
+		l.principal_amount,
+		l.currency,
+		l.tenor_months,
+		l.created_at AS proposed_at,
+		la.assigned_at
+	FROM loan_assignment la
+	JOIN loan l ON l.id = la.loan_id
+	WHERE la.validator_id = $1 AND la.status = $2 AND l.state = ANY($3) AND l.deleted_at IS NULL
+	ORDER BY la.assigned_at, la.id
+	`
+
+	values := make([]string, 0, len(states))
+	for _, state := range states {
+		values = append(values, string(state))
+	}
+	if err = r.db.SelectContext(ctx, &assignments, query, validatorId, constant.LoanAssignmentStatusAssigned, pq.Array(values)); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
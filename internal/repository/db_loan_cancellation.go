@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateLoanCancellation inserts a new loan cancellation record into the database.
+func (r *dbRepository) CreateLoanCancellation(ctx context.Context, cancellation *model.LoanCancellation, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO loan_cancellation (
+		loan_id,
+		borrower_id,
+		reason,
+		reversed_amount,
+		cancellation_date,
+		created_by
+	) VALUES (
+		:loan_id,
+		:borrower_id,
+		:reason,
+		:reversed_amount,
+		:cancellation_date,
+		:created_by
+	)
+	RETURNING id
+	`
+
+	insertCancellationQuery, args, err := tx.BindNamed(query, cancellation)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, insertCancellationQuery, args...).Scan(&cancellation.Id)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
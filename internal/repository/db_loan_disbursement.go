@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/ffauzann/loan-service/internal/model"
 	"github.com/ffauzann/loan-service/internal/util"
@@ -50,3 +51,31 @@ func (r *dbRepository) CreateLoanDisbursement(ctx context.Context, disbursement
 
 	return
 }
+
+// GetLoanDisbursementByLoanId returns the disbursement of a loan, or nil if the loan was not disbursed.
+func (r *dbRepository) GetLoanDisbursementByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) (disbursement *model.LoanDisbursement, err error) {
+	disbursement = &model.LoanDisbursement{}
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return nil, err
+	}
+
+	query := `
+	SELECT *
+	FROM loan_disbursement
+	WHERE loan_id = $1
+	`
+
+	err = tx.GetContext(ctx, disbursement, query, loanId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// CreateLoanInstallments inserts the whole repayment schedule of a loan in a single statement.
+func (r *dbRepository) CreateLoanInstallments(ctx context.Context, installments []*model.LoanInstallment, tx *sqlx.Tx) (err error) {
+	if len(installments) == 0 {
+		return
+	}
+
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO loan_installment (
+		loan_id,
+		installment_number,
+		due_date,
+		principal_amount,
+		interest_amount,
+		status,
+		created_by,
+		updated_by
+	) VALUES (
+		:loan_id,
+		:installment_number,
+		:due_date,
+		:principal_amount,
+		:interest_amount,
+		:status,
+		:created_by,
+		:updated_by
+	)
+	`
+
+	_, err = tx.NamedExecContext(ctx, query, installments)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetInstallmentsByLoanId returns the repayment schedule of a given loan ID ordered by installment number.
+func (r *dbRepository) GetInstallmentsByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) (installments []*model.LoanInstallment, err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT *
+	FROM loan_installment
+	WHERE loan_id = $1
+	ORDER BY installment_number
+	`
+
+	if err = tx.SelectContext(ctx, &installments, query, loanId); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// UpdateLoanInstallment updates the fee, paid amounts and status of an installment.
+func (r *dbRepository) UpdateLoanInstallment(ctx context.Context, installment *model.LoanInstallment, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	UPDATE loan_installment
+	SET
+		due_date            = :due_date,
+		principal_amount    = :principal_amount,
+		interest_amount     = :interest_amount,
+		fee_amount          = :fee_amount,
+		paid_fee            = :paid_fee,
+		paid_interest       = :paid_interest,
+		paid_principal      = :paid_principal,
+		paid_at             = :paid_at,
+		late_fee_charged_at = :late_fee_charged_at,
+		status              = :status,
+		updated_at          = NOW(),
+		updated_by          = :updated_by
+	WHERE id = :id
+	`
+
+	query, args, err := tx.BindNamed(query, installment)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// DeleteLoanInstallments deletes installments dropped from a recalculated schedule.
+func (r *dbRepository) DeleteLoanInstallments(ctx context.Context, installmentIds []uint64, tx *sqlx.Tx) (err error) {
+	if len(installmentIds) == 0 {
+		return
+	}
+
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `DELETE FROM loan_installment WHERE id = ANY($1)`
+
+	_, err = tx.ExecContext(ctx, query, pq.Array(installmentIds))
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// ArchiveLoanInstallments copies the current schedule of a loan into the installment history of a restructure.
+func (r *dbRepository) ArchiveLoanInstallments(ctx context.Context, loanId, restructureId uint64, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO loan_installment_history (
+		restructure_id,
+		loan_id,
+		installment_number,
+		due_date,
+		principal_amount,
+		interest_amount,
+		fee_amount,
+		paid_fee,
+		paid_interest,
+		paid_principal,
+		paid_at,
+		status
+	)
+	SELECT
+		$1,
+		loan_id,
+		installment_number,
+		due_date,
+		principal_amount,
+		interest_amount,
+		fee_amount,
+		paid_fee,
+		paid_interest,
+		paid_principal,
+		paid_at,
+		status
+	FROM loan_installment
+	WHERE loan_id = $2
+	`
+
+	_, err = tx.ExecContext(ctx, query, restructureId, loanId)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
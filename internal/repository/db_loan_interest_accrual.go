@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// CreateLoanInterestAccrual inserts the accrual of a loan for a date, unless the date was already accrued.
+// posted is false when an accrual already existed for the date.
+func (r *dbRepository) CreateLoanInterestAccrual(ctx context.Context, accrual *model.LoanInterestAccrual, tx *sqlx.Tx) (posted bool, err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO loan_interest_accrual (
+		loan_id,
+		accrual_date,
+		principal_amount,
+		interest_rate,
+		day_count_convention,
+		amount,
+		currency,
+		created_by
+	) VALUES (
+		:loan_id,
+		:accrual_date,
+		:principal_amount,
+		:interest_rate,
+		:day_count_convention,
+		:amount,
+		:currency,
+		:created_by
+	)
+	ON CONFLICT (loan_id, accrual_date) DO NOTHING
+	RETURNING id
+	`
+
+	query, args, err := tx.BindNamed(query, accrual)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, query, args...).Scan(&accrual.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return true, nil
+}
+
+// GetLoanInterestAccrualSummary sums the accruals of a loan and returns the first and last accrued dates.
+func (r *dbRepository) GetLoanInterestAccrualSummary(ctx context.Context, loanId uint64, tx *sqlx.Tx) (summary *model.LoanInterestAccrualSummary, err error) {
+	summary = &model.LoanInterestAccrualSummary{}
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return nil, err
+	}
+
+	query := `
+	SELECT
+		COALESCE(SUM(amount), 0) AS accrued_amount,
+		COUNT(*) AS accrued_days,
+		MIN(accrual_date) AS first_accrual_date,
+		MAX(accrual_date) AS last_accrual_date
+	FROM loan_interest_accrual
+	WHERE loan_id = $1
+	`
+
+	err = tx.GetContext(ctx, summary, query, loanId)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetAccruingLoanIds returns the IDs of loans in any of the given states, along with the loans that stopped accruing
+// before every day up to their accrual end date was accrued.
+func (r *dbRepository) GetAccruingLoanIds(ctx context.Context, states []constant.LoanState) (loanIds []uint64, err error) {
+	query := `
+	SELECT l.id
+	FROM loan l
+	JOIN loan_disbursement d ON d.loan_id = l.id
+	LEFT JOIN LATERAL (
+		SELECT MAX(accrual_date) AS last_accrual_date
+		FROM loan_interest_accrual
+		WHERE loan_id = l.id
+	) a ON TRUE
+	WHERE l.deleted_at IS NULL AND (
+		l.state = ANY($1) OR
+		l.accrual_end_date >= COALESCE(a.last_accrual_date + 1, d.disbursement_date)
+	)
+	ORDER BY l.id
+	`
+
+	values := make([]string, 0, len(states))
+	for _, state := range states {
+		values = append(values, string(state))
+	}
+	if err = r.db.SelectContext(ctx, &loanIds, query, pq.Array(values)); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
@@ -2,10 +2,14 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 
+	"github.com/ffauzann/loan-service/internal/constant"
 	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
 	"github.com/ffauzann/loan-service/internal/util"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 func (r *dbRepository) CreateLoanInvestment(ctx context.Context, investment *model.LoanInvestment, tx *sqlx.Tx) (err error) {
@@ -24,11 +28,13 @@ func (r *dbRepository) CreateLoanInvestment(ctx context.Context, investment *mod
 		loan_id,
 		investor_id,
 		amount,
+		currency,
 		created_by
 	) VALUES (
 		:loan_id,
 		:investor_id,
 		:amount,
+		:currency,
 		:created_by
 	)
 	RETURNING id
@@ -48,7 +54,7 @@ func (r *dbRepository) CreateLoanInvestment(ctx context.Context, investment *mod
 	return
 }
 
-// GetInvesmentsByLoanId returns all investments for a given loan ID.
+// GetInvesmentsByLoanId returns all active investments for a given loan ID.
 func (r *dbRepository) GetInvestmentsByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) (investments []*model.LoanInvestment, err error) {
 	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
 		defer func() { r.EndTx(ctx, tx, err) }()
@@ -63,10 +69,256 @@ func (r *dbRepository) GetInvestmentsByLoanId(ctx context.Context, loanId uint64
 	query := `
 	SELECT *
 	FROM loan_investment
-	WHERE loan_id = $1
+	WHERE loan_id = $1 AND status = $2
 	`
 
-	if err = tx.SelectContext(ctx, &investments, query, loanId); err != nil {
+	if err = tx.SelectContext(ctx, &investments, query, loanId, constant.LoanInvestmentStatusActive); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetLoanInvestmentById retrieves an investment by its ID.
+func (r *dbRepository) GetLoanInvestmentById(ctx context.Context, investmentId uint64, tx *sqlx.Tx) (investment *model.LoanInvestment, err error) {
+	investment = &model.LoanInvestment{}
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return nil, err
+	}
+
+	query := `
+	SELECT *
+	FROM loan_investment
+	WHERE id = $1
+	`
+
+	err = tx.GetContext(ctx, investment, query, investmentId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, constant.ErrInvestmentNotFound
+		}
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// UpdateLoanInvestmentStatus updates the status of an investment and when it was reversed.
+func (r *dbRepository) UpdateLoanInvestmentStatus(ctx context.Context, investment *model.LoanInvestment, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	UPDATE loan_investment
+	SET
+		status      = :status,
+		reversed_at = :reversed_at
+	WHERE id = :id
+	`
+
+	query, args, err := tx.BindNamed(query, investment)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// ReverseLoanInvestments moves every active investment of a given loan ID to the given status (REVERSED/REFUNDED) and returns the affected rows.
+func (r *dbRepository) ReverseLoanInvestments(ctx context.Context, loanId uint64, status constant.LoanInvestmentStatus, tx *sqlx.Tx) (investments []*model.LoanInvestment, err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	UPDATE loan_investment
+	SET
+		status      = $1,
+		reversed_at = NOW()
+	WHERE loan_id = $2 AND status = $3
+	RETURNING *
+	`
+
+	if err = tx.SelectContext(ctx, &investments, query, status, loanId, constant.LoanInvestmentStatusActive); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetInvestorLoanHolding sums the active investments of an investor in a given loan.
+func (r *dbRepository) GetInvestorLoanHolding(ctx context.Context, loanId, investorId uint64, tx *sqlx.Tx) (holding money.Amount, err error) {
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT COALESCE(SUM(amount), 0)
+	FROM loan_investment
+	WHERE loan_id = $1 AND investor_id = $2 AND status = $3
+	`
+
+	if err = tx.QueryRowxContext(ctx, query, loanId, investorId, constant.LoanInvestmentStatusActive).Scan(&holding); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetInvestorBorrowerExposure sums the active investments of an investor in the loans of a borrower in a currency,
+// except loans in the given states.
+func (r *dbRepository) GetInvestorBorrowerExposure(ctx context.Context, investorId, borrowerId uint64, currency string, excludedStates []constant.LoanState, tx *sqlx.Tx) (exposure money.Amount, err error) {
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT COALESCE(SUM(li.amount), 0)
+	FROM loan_investment li
+	JOIN loan l ON l.id = li.loan_id
+	WHERE li.investor_id = $1 AND l.borrower_id = $2 AND li.currency = $3 AND li.status = $4
+		AND l.state <> ALL($5) AND l.deleted_at IS NULL
+	`
+
+	values := make([]string, 0, len(excludedStates))
+	for _, state := range excludedStates {
+		values = append(values, string(state))
+	}
+	if err = tx.QueryRowxContext(ctx, query, investorId, borrowerId, currency, constant.LoanInvestmentStatusActive, pq.Array(values)).Scan(&exposure); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// portfolioHoldingsQuery selects every active investment of the investor given as $1 with the figures of its loan.
+// The outstanding principal of a holding is its share of the principal the loan has not repaid yet, which stays right
+// across secondary market transfers, unlike subtracting the payouts of the investment itself.
+// The expected return of a holding is its share of the investor return on the scheduled interest, capped by the ROI as
+// the payouts are. Loans not disbursed yet have no schedule, so their annuity interest is estimated from their terms.
+const portfolioHoldingsQuery = `
+	SELECT
+		li.id AS investment_id,
+		li.loan_id,
+		l.state AS loan_state,
+		li.currency,
+		li.amount AS invested_amount,
+		li.invested_at,
+		ROUND(li.amount * 100 / l.principal_amount, 4) AS ownership_percentage,
+		l.roi,
+		ROUND(CASE WHEN l.interest_rate > 0 THEN
+			COALESCE(
+				i.interest_amount,
+				l.principal_amount * l.interest_rate / 1200 * l.tenor_months / (1 - POWER(1 + l.interest_rate / 1200, -l.tenor_months)) - l.principal_amount
+			) * LEAST(l.roi / l.interest_rate, 1) * li.amount / l.principal_amount
+		ELSE 0 END, 2) AS expected_return,
+		COALESCE(p.principal_amount, 0) AS received_principal,
+		COALESCE(p.return_amount, 0) AS received_return,
+		ROUND(li.amount * (l.principal_amount - COALESCE(r.principal_amount, 0)) / l.principal_amount, 2) AS outstanding_principal
+	FROM loan_investment li
+	JOIN loan l ON l.id = li.loan_id
+	LEFT JOIN LATERAL (
+		SELECT SUM(principal_amount) AS principal_amount, SUM(return_amount) AS return_amount
+		FROM investor_payout
+		WHERE investment_id = li.id
+	) p ON TRUE
+	LEFT JOIN LATERAL (
+		SELECT SUM(applied_principal) AS principal_amount
+		FROM loan_repayment
+		WHERE loan_id = li.loan_id
+	) r ON TRUE
+	LEFT JOIN LATERAL (
+		SELECT SUM(interest_amount) AS interest_amount
+		FROM loan_installment
+		WHERE loan_id = li.loan_id
+	) i ON TRUE
+	WHERE li.investor_id = $1 AND li.status = 'ACTIVE' AND l.deleted_at IS NULL
+`
+
+// GetPortfolioHoldings returns a page of the active investments of an investor, newest first,
+// starting right after afterId when set.
+func (r *dbRepository) GetPortfolioHoldings(ctx context.Context, investorId, afterId uint64, limit uint32, tx *sqlx.Tx) (holdings []*model.PortfolioHolding, err error) {
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT *
+	FROM (` + portfolioHoldingsQuery + `) h
+	WHERE ($2 = 0 OR investment_id < $2)
+	ORDER BY investment_id DESC
+	LIMIT $3
+	`
+
+	if err = tx.SelectContext(ctx, &holdings, query, investorId, afterId, limit); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetPortfolioStateSummaries aggregates the active investments of an investor by currency and loan state.
+func (r *dbRepository) GetPortfolioStateSummaries(ctx context.Context, investorId uint64, tx *sqlx.Tx) (summaries []*model.PortfolioStateSummary, err error) {
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT
+		currency,
+		loan_state,
+		COUNT(1) AS positions,
+		SUM(invested_amount) AS invested_amount,
+		SUM(expected_return) AS expected_return,
+		SUM(received_principal) AS received_principal,
+		SUM(received_return) AS received_return,
+		SUM(outstanding_principal) AS outstanding_principal
+	FROM (` + portfolioHoldingsQuery + `) h
+	GROUP BY currency, loan_state
+	ORDER BY currency, loan_state
+	`
+
+	if err = tx.SelectContext(ctx, &summaries, query, investorId); err != nil {
 		util.LogContext(ctx).Error(err.Error())
 		return
 	}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateLoanInvestmentReservation inserts a new hold on the capacity of a loan.
+func (r *dbRepository) CreateLoanInvestmentReservation(ctx context.Context, reservation *model.LoanInvestmentReservation, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO loan_investment_reservation (
+		loan_id,
+		investor_id,
+		amount,
+		currency,
+		status,
+		expires_at,
+		created_by,
+		updated_by
+	) VALUES (
+		:loan_id,
+		:investor_id,
+		:amount,
+		:currency,
+		:status,
+		:expires_at,
+		:created_by,
+		:updated_by
+	)
+	RETURNING id
+	`
+
+	query, args, err := tx.BindNamed(query, reservation)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, query, args...).Scan(&reservation.Id)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetLoanInvestmentReservationById retrieves a reservation by its ID.
+func (r *dbRepository) GetLoanInvestmentReservationById(ctx context.Context, reservationId uint64, tx *sqlx.Tx) (reservation *model.LoanInvestmentReservation, err error) {
+	reservation = &model.LoanInvestmentReservation{}
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return nil, err
+	}
+
+	query := `
+	SELECT *
+	FROM loan_investment_reservation
+	WHERE id = $1
+	`
+
+	err = tx.GetContext(ctx, reservation, query, reservationId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, constant.ErrReservationNotFound
+		}
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// UpdateLoanInvestmentReservation updates the status of a reservation and the investment it was confirmed into.
+func (r *dbRepository) UpdateLoanInvestmentReservation(ctx context.Context, reservation *model.LoanInvestmentReservation, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	UPDATE loan_investment_reservation
+	SET
+		status        = :status,
+		investment_id = :investment_id,
+		confirmed_at  = :confirmed_at,
+		released_at   = :released_at,
+		updated_at    = NOW(),
+		updated_by    = :updated_by
+	WHERE id = :id
+	`
+
+	query, args, err := tx.BindNamed(query, reservation)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetLoanReservedAmount sums the amounts held on a loan by reservations still unexpired at the given time.
+// A zero investorId sums the reservations of every investor.
+func (r *dbRepository) GetLoanReservedAmount(ctx context.Context, loanId, investorId uint64, at time.Time, tx *sqlx.Tx) (reserved money.Amount, err error) {
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT COALESCE(SUM(amount), 0)
+	FROM loan_investment_reservation
+	WHERE loan_id = $1 AND ($2 = 0 OR investor_id = $2) AND status = $3 AND expires_at > $4
+	`
+
+	if err = tx.QueryRowxContext(ctx, query, loanId, investorId, constant.LoanInvestmentReservationStatusHeld, at).Scan(&reserved); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// ExpireLoanInvestmentReservations moves every held reservation expired at the given time to EXPIRED and returns their IDs.
+func (r *dbRepository) ExpireLoanInvestmentReservations(ctx context.Context, at time.Time) (reservationIds []uint64, err error) {
+	query := `
+	UPDATE loan_investment_reservation
+	SET
+		status      = $1,
+		released_at = $3,
+		updated_at  = NOW()
+	WHERE status = $2 AND expires_at <= $3
+	RETURNING id
+	`
+
+	if err = r.db.SelectContext(ctx, &reservationIds, query, constant.LoanInvestmentReservationStatusExpired, constant.LoanInvestmentReservationStatusHeld, at); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
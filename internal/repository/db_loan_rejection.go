@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateLoanRejection inserts a new loan rejection record into the database.
+func (r *dbRepository) CreateLoanRejection(ctx context.Context, rejection *model.LoanRejection, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO loan_rejection (
+		loan_id,
+		validator_id,
+		reason_code,
+		note,
+		rejection_date,
+		created_by
+	) VALUES (
+		:loan_id,
+		:validator_id,
+		:reason_code,
+		:note,
+		:rejection_date,
+		:created_by
+	)
+	RETURNING id
+	`
+
+	insertRejectionQuery, args, err := tx.BindNamed(query, rejection)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, insertRejectionQuery, args...).Scan(&rejection.Id)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateLoanRepayment inserts a new loan repayment record into the database.
+func (r *dbRepository) CreateLoanRepayment(ctx context.Context, repayment *model.LoanRepayment, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO loan_repayment (
+		loan_id,
+		payer_id,
+		amount,
+		applied_fee,
+		applied_interest,
+		applied_principal,
+		excess_amount,
+		reference,
+		payment_date,
+		created_by
+	) VALUES (
+		:loan_id,
+		:payer_id,
+		:amount,
+		:applied_fee,
+		:applied_interest,
+		:applied_principal,
+		:excess_amount,
+		:reference,
+		:payment_date,
+		:created_by
+	)
+	RETURNING id
+	`
+
+	insertRepaymentQuery, args, err := tx.BindNamed(query, repayment)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, insertRepaymentQuery, args...).Scan(&repayment.Id)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetLoanRepaymentsByLoanId returns the repayments of a loan, oldest first.
+func (r *dbRepository) GetLoanRepaymentsByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) (repayments []*model.LoanRepayment, err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	SELECT *
+	FROM loan_repayment
+	WHERE loan_id = $1
+	ORDER BY payment_date, id
+	`
+
+	err = tx.SelectContext(ctx, &repayments, query, loanId)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
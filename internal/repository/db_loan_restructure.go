@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateLoanRestructure inserts a new loan restructure request into the database.
+func (r *dbRepository) CreateLoanRestructure(ctx context.Context, restructure *model.LoanRestructure, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO loan_restructure (
+		loan_id,
+		status,
+		extend_tenor_months,
+		grace_period_months,
+		previous_interest_rate,
+		new_interest_rate,
+		previous_tenor_months,
+		new_tenor_months,
+		reason,
+		requested_by,
+		created_by,
+		updated_by
+	) VALUES (
+		:loan_id,
+		:status,
+		:extend_tenor_months,
+		:grace_period_months,
+		:previous_interest_rate,
+		:new_interest_rate,
+		:previous_tenor_months,
+		:new_tenor_months,
+		:reason,
+		:requested_by,
+		:created_by,
+		:updated_by
+	)
+	RETURNING id
+	`
+
+	insertRestructureQuery, args, err := tx.BindNamed(query, restructure)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, insertRestructureQuery, args...).Scan(&restructure.Id)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetLoanRestructureById returns a loan restructure by its ID.
+func (r *dbRepository) GetLoanRestructureById(ctx context.Context, restructureId uint64, tx *sqlx.Tx) (restructure *model.LoanRestructure, err error) {
+	restructure = &model.LoanRestructure{}
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return nil, err
+	}
+
+	query := `
+	SELECT *
+	FROM loan_restructure
+	WHERE id = $1
+	`
+
+	err = tx.GetContext(ctx, restructure, query, restructureId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, constant.ErrRestructureNotFound
+		}
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// HasPendingLoanRestructure checks whether a loan has a restructure waiting for review.
+func (r *dbRepository) HasPendingLoanRestructure(ctx context.Context, loanId uint64, tx *sqlx.Tx) (isPending bool, err error) {
+	// Use provided transaction or init one if nil
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	var count int
+	query := `SELECT COUNT(1) FROM loan_restructure WHERE loan_id = $1 AND status = $2`
+	if err = tx.QueryRowxContext(ctx, query, loanId, constant.LoanRestructureStatusPending).Scan(&count); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return count > 0, nil
+}
+
+// UpdateLoanRestructure updates the review outcome of a loan restructure.
+func (r *dbRepository) UpdateLoanRestructure(ctx context.Context, restructure *model.LoanRestructure, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	UPDATE loan_restructure
+	SET
+		status             = :status,
+		new_tenor_months   = :new_tenor_months,
+		capitalized_amount = :capitalized_amount,
+		reviewed_by        = :reviewed_by,
+		review_note        = :review_note,
+		reviewed_at        = :reviewed_at,
+		updated_at         = NOW(),
+		updated_by         = :updated_by
+	WHERE id = :id
+	`
+
+	query, args, err := tx.BindNamed(query, restructure)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
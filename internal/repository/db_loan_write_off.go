@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateLoanWriteOff inserts a new loan write-off record into the database.
+func (r *dbRepository) CreateLoanWriteOff(ctx context.Context, writeOff *model.LoanWriteOff, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO loan_write_off (
+		loan_id,
+		outstanding_principal,
+		outstanding_interest,
+		outstanding_fee,
+		reason,
+		write_off_date,
+		created_by,
+		updated_by
+	) VALUES (
+		:loan_id,
+		:outstanding_principal,
+		:outstanding_interest,
+		:outstanding_fee,
+		:reason,
+		:write_off_date,
+		:created_by,
+		:updated_by
+	)
+	RETURNING id
+	`
+
+	insertWriteOffQuery, args, err := tx.BindNamed(query, writeOff)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = tx.QueryRowxContext(ctx, insertWriteOffQuery, args...).Scan(&writeOff.Id)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// AddLoanWriteOffRecovery adds amount to the recovered amount of a written off loan.
+func (r *dbRepository) AddLoanWriteOffRecovery(ctx context.Context, loanId uint64, amount money.Amount, tx *sqlx.Tx) (err error) {
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	UPDATE loan_write_off
+	SET
+		recovered_amount = recovered_amount + $1,
+		updated_at       = NOW()
+	WHERE loan_id = $2
+	`
+
+	_, err = tx.ExecContext(ctx, query, amount, loanId)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// CreateInvestorLosses inserts the losses of a write-off in a single statement.
+func (r *dbRepository) CreateInvestorLosses(ctx context.Context, losses []*model.InvestorLoss, tx *sqlx.Tx) (err error) {
+	if len(losses) == 0 {
+		return
+	}
+
+	if tx == nil { // End tx as soon as this method finishes if tx was not provided.
+		defer func() { r.EndTx(ctx, tx, err) }()
+	}
+
+	tx, err = r.useOrInitTx(ctx, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	query := `
+	INSERT INTO investor_loss (
+		loan_id,
+		write_off_id,
+		investment_id,
+		investor_id,
+		loss_amount,
+		created_by
+	) VALUES (
+		:loan_id,
+		:write_off_id,
+		:investment_id,
+		:investor_id,
+		:loss_amount,
+		:created_by
+	)
+	`
+
+	_, err = tx.NamedExecContext(ctx, query, losses)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// PutDocument writes a document to the local filesystem under key, replacing any previous content, and returns its link.
+func (r *localDocumentRepository) PutDocument(ctx context.Context, key string, content []byte) (link string, err error) {
+	path := filepath.Join(r.dir, filepath.FromSlash(key))
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil { //nolint
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Write to a temporary file first so a document is never read half written.
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, content, 0o640); err != nil { //nolint
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	if r.baseUrl != "" {
+		return strings.TrimRight(r.baseUrl, "/") + "/" + key, nil
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(abs)}).String(), nil
+}
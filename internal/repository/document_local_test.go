@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalPutDocument(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		dir     = t.TempDir()
+		key     = "loans/1/agreements/borrower-v1.txt"
+		content = []byte("LOAN AGREEMENT")
+	)
+
+	// Links to the file itself without a base URL.
+	r := &localDocumentRepository{dir: dir}
+	link, err := r.PutDocument(ctx, key, content)
+	assert.NoError(t, err)
+	assert.Equal(t, "file://"+filepath.ToSlash(filepath.Join(dir, key)), link)
+
+	got, err := os.ReadFile(filepath.Join(dir, key))
+	assert.NoError(t, err)
+	assert.Equal(t, content, got)
+
+	// Overwrites and links under the base URL.
+	r.baseUrl = "https://documents.example.com/"
+	link, err = r.PutDocument(ctx, key, []byte("LOAN AGREEMENT v2"))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://documents.example.com/"+key, link)
+
+	got, err = os.ReadFile(filepath.Join(dir, key))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("LOAN AGREEMENT v2"), got)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ffauzann/loan-service/internal/constant"
 	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
 	"github.com/segmentio/kafka-go"
 
 	"github.com/jmoiron/sqlx"
@@ -67,6 +68,17 @@ func NewNotification(client *smtp.Client, config *model.AppConfig, logger *zap.L
 	}
 }
 
+func NewLocalDocument(dir, baseUrl string, config *model.AppConfig, logger *zap.Logger) DocumentRepository {
+	return &localDocumentRepository{
+		dir:     dir,
+		baseUrl: baseUrl,
+		common: common{
+			config: config,
+			logger: logger,
+		},
+	}
+}
+
 type DBRepository interface {
 	DBTxRepository
 	DBUserRepository
@@ -91,11 +103,77 @@ type DBLoanRepository interface {
 	CreateLoan(ctx context.Context, loan *model.Loan, tx *sqlx.Tx) error
 	GetLoanById(ctx context.Context, loanID uint64, tx *sqlx.Tx) (loan *model.Loan, err error)
 	UpdateLoan(ctx context.Context, loan *model.Loan, tx *sqlx.Tx) (err error)
+	GetOverdueLoanIds(ctx context.Context, deadline time.Time) (loanIds []uint64, err error)
+	GetLoanIdsByStates(ctx context.Context, states []constant.LoanState) (loanIds []uint64, err error)
+	GetLoans(ctx context.Context, filter *model.LoanFilter) (loans []*model.Loan, err error)
 	ApproveLoan(ctx context.Context, approval *model.LoanApproval, tx *sqlx.Tx) error
+	GetLoanApprovalsByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) (approvals []*model.LoanApproval, err error)
+	CreateLoanAssignment(ctx context.Context, assignment *model.LoanAssignment, tx *sqlx.Tx) error
+	GetOpenLoanAssignment(ctx context.Context, loanId uint64, tx *sqlx.Tx) (assignment *model.LoanAssignment, err error)
+	UpdateLoanAssignment(ctx context.Context, assignment *model.LoanAssignment, tx *sqlx.Tx) error
+	GetValidatorWorkloads(ctx context.Context, states []constant.LoanState, tx *sqlx.Tx) (workloads []*model.ValidatorWorkload, err error)
+	GetLastAssignedValidatorId(ctx context.Context, tx *sqlx.Tx) (validatorId uint64, err error)
+	GetUnassignedLoans(ctx context.Context, states []constant.LoanState) (loans []*model.QueuedLoan, err error)
+	GetPendingAssignments(ctx context.Context, validatorId uint64, states []constant.LoanState) (assignments []*model.PendingAssignment, err error)
+	CreateLoanRejection(ctx context.Context, rejection *model.LoanRejection, tx *sqlx.Tx) error
 	CreateLoanInvestment(ctx context.Context, investment *model.LoanInvestment, tx *sqlx.Tx) error
 	CreateLoanDisbursement(ctx context.Context, disbursement *model.LoanDisbursement, tx *sqlx.Tx) (err error)
+	GetLoanDisbursementByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) (disbursement *model.LoanDisbursement, err error)
 
 	GetInvestmentsByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) ([]*model.LoanInvestment, error)
+	GetLoanInvestmentById(ctx context.Context, investmentId uint64, tx *sqlx.Tx) (investment *model.LoanInvestment, err error)
+	UpdateLoanInvestmentStatus(ctx context.Context, investment *model.LoanInvestment, tx *sqlx.Tx) error
+	ReverseLoanInvestments(ctx context.Context, loanId uint64, status constant.LoanInvestmentStatus, tx *sqlx.Tx) ([]*model.LoanInvestment, error)
+	GetInvestorLoanHolding(ctx context.Context, loanId, investorId uint64, tx *sqlx.Tx) (holding money.Amount, err error)
+	GetInvestorBorrowerExposure(ctx context.Context, investorId, borrowerId uint64, currency string, excludedStates []constant.LoanState, tx *sqlx.Tx) (exposure money.Amount, err error)
+	GetPortfolioHoldings(ctx context.Context, investorId, afterId uint64, limit uint32, tx *sqlx.Tx) (holdings []*model.PortfolioHolding, err error)
+	GetPortfolioStateSummaries(ctx context.Context, investorId uint64, tx *sqlx.Tx) (summaries []*model.PortfolioStateSummary, err error)
+	CreateLoanInvestmentReservation(ctx context.Context, reservation *model.LoanInvestmentReservation, tx *sqlx.Tx) error
+	GetLoanInvestmentReservationById(ctx context.Context, reservationId uint64, tx *sqlx.Tx) (reservation *model.LoanInvestmentReservation, err error)
+	UpdateLoanInvestmentReservation(ctx context.Context, reservation *model.LoanInvestmentReservation, tx *sqlx.Tx) error
+	GetLoanReservedAmount(ctx context.Context, loanId, investorId uint64, at time.Time, tx *sqlx.Tx) (reserved money.Amount, err error)
+	ExpireLoanInvestmentReservations(ctx context.Context, at time.Time) (reservationIds []uint64, err error)
+	CreateInvestmentListing(ctx context.Context, listing *model.InvestmentListing, tx *sqlx.Tx) error
+	GetInvestmentListingById(ctx context.Context, listingId uint64, tx *sqlx.Tx) (listing *model.InvestmentListing, err error)
+	HasOpenInvestmentListing(ctx context.Context, investmentId uint64, tx *sqlx.Tx) (isListed bool, err error)
+	UpdateInvestmentListing(ctx context.Context, listing *model.InvestmentListing, tx *sqlx.Tx) error
+	GetInvestmentListings(ctx context.Context, loanId uint64, status constant.InvestmentListingStatus) (listings []*model.InvestmentListing, err error)
+	CreateInvestmentTransfer(ctx context.Context, transfer *model.InvestmentTransfer, tx *sqlx.Tx) error
+	GetInvestmentTransfers(ctx context.Context, loanId, investorId uint64) (transfers []*model.InvestmentTransfer, err error)
+	CreateLoanCancellation(ctx context.Context, cancellation *model.LoanCancellation, tx *sqlx.Tx) error
+	CreateLoanInstallments(ctx context.Context, installments []*model.LoanInstallment, tx *sqlx.Tx) error
+	GetInstallmentsByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) ([]*model.LoanInstallment, error)
+	UpdateLoanInstallment(ctx context.Context, installment *model.LoanInstallment, tx *sqlx.Tx) error
+	DeleteLoanInstallments(ctx context.Context, installmentIds []uint64, tx *sqlx.Tx) error
+	ArchiveLoanInstallments(ctx context.Context, loanId, restructureId uint64, tx *sqlx.Tx) error
+	CreateLoanRepayment(ctx context.Context, repayment *model.LoanRepayment, tx *sqlx.Tx) error
+	GetLoanRepaymentsByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) (repayments []*model.LoanRepayment, err error)
+	CreateInvestorPayouts(ctx context.Context, payouts []*model.InvestorPayout, tx *sqlx.Tx) error
+	CreateLoanWriteOff(ctx context.Context, writeOff *model.LoanWriteOff, tx *sqlx.Tx) error
+	AddLoanWriteOffRecovery(ctx context.Context, loanId uint64, amount money.Amount, tx *sqlx.Tx) error
+	CreateInvestorLosses(ctx context.Context, losses []*model.InvestorLoss, tx *sqlx.Tx) error
+	CreateLoanRestructure(ctx context.Context, restructure *model.LoanRestructure, tx *sqlx.Tx) error
+	GetLoanRestructureById(ctx context.Context, restructureId uint64, tx *sqlx.Tx) (restructure *model.LoanRestructure, err error)
+	HasPendingLoanRestructure(ctx context.Context, loanId uint64, tx *sqlx.Tx) (isPending bool, err error)
+	UpdateLoanRestructure(ctx context.Context, restructure *model.LoanRestructure, tx *sqlx.Tx) error
+
+	GetBorrowerCreditLimit(ctx context.Context, borrowerId uint64, currency string, tx *sqlx.Tx) (limit *model.BorrowerCreditLimit, err error)
+	UpsertBorrowerCreditLimit(ctx context.Context, limit *model.BorrowerCreditLimit, tx *sqlx.Tx) error
+	CreateBorrowerCreditLimitAudit(ctx context.Context, audit *model.BorrowerCreditLimitAudit, tx *sqlx.Tx) error
+	GetBorrowerExposure(ctx context.Context, borrowerId uint64, currency string, excludedStates []constant.LoanState, tx *sqlx.Tx) (exposure money.Amount, err error)
+
+	CreateAutoInvestRule(ctx context.Context, rule *model.AutoInvestRule, tx *sqlx.Tx) error
+	GetAutoInvestRuleById(ctx context.Context, ruleId uint64, tx *sqlx.Tx) (rule *model.AutoInvestRule, err error)
+	GetAutoInvestRulesByInvestorId(ctx context.Context, investorId uint64, tx *sqlx.Tx) (rules []*model.AutoInvestRule, err error)
+	GetAutoInvestRulesByCurrency(ctx context.Context, currency string, tx *sqlx.Tx) (rules []*model.AutoInvestRule, err error)
+	SetAutoInvestRuleInvestedAt(ctx context.Context, ruleId uint64, investedAt time.Time, tx *sqlx.Tx) error
+	DeleteAutoInvestRule(ctx context.Context, rule *model.AutoInvestRule, tx *sqlx.Tx) error
+
+	CreateLoanInterestAccrual(ctx context.Context, accrual *model.LoanInterestAccrual, tx *sqlx.Tx) (posted bool, err error)
+	GetLoanInterestAccrualSummary(ctx context.Context, loanId uint64, tx *sqlx.Tx) (summary *model.LoanInterestAccrualSummary, err error)
+	GetAccruingLoanIds(ctx context.Context, states []constant.LoanState) (loanIds []uint64, err error)
+
+	CreateLoanAgreement(ctx context.Context, agreement *model.LoanAgreement, tx *sqlx.Tx) error
 }
 
 type RedisRepository interface {
@@ -110,6 +188,10 @@ type NotificationRepository interface {
 	SendMail(ctx context.Context, req *model.EmailRequest) error
 }
 
+type DocumentRepository interface {
+	PutDocument(ctx context.Context, key string, content []byte) (link string, err error)
+}
+
 type common struct {
 	config *model.AppConfig
 	logger *zap.Logger
@@ -137,4 +219,10 @@ type notificationRepository struct {
 	common
 }
 
+type localDocumentRepository struct {
+	dir     string // Directory documents are written to.
+	baseUrl string // URL dir is served from, empty for file:// links.
+	common
+}
+
 var now = time.Now // For mocking purpose later.
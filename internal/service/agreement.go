@@ -0,0 +1,191 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// agreementTemplateVersion is the template version new agreements are generated from.
+const agreementTemplateVersion = "v1"
+
+// agreementTemplates are the agreement templates by version and type. A published version must never change,
+// new wording goes into a new version so every generated document can be traced to the exact text it was rendered from.
+var agreementTemplates = map[string]map[constant.LoanAgreementType]*template.Template{
+	"v1": {
+		constant.LoanAgreementTypeBorrower: template.Must(template.New("borrower-v1").Parse(`LOAN AGREEMENT
+Template version: {{.Version}}
+Date: {{.Date}}
+
+Loan ID: {{.LoanId}}
+Borrower ID: {{.BorrowerId}}
+Principal: {{.Currency}} {{.PrincipalAmount}}
+Interest rate: {{.InterestRate}}% per annum
+Tenor: {{.TenorMonths}} month(s)
+
+The borrower receives the principal above, funded by {{.LenderCount}} lender(s), and agrees to repay it together with
+interest in {{.TenorMonths}} monthly installment(s) according to the repayment schedule issued on disbursement.
+Installments paid late are charged the late fee in force at the time.
+`)),
+		constant.LoanAgreementTypeLender: template.Must(template.New("lender-v1").Parse(`LENDER AGREEMENT
+Template version: {{.Version}}
+Date: {{.Date}}
+
+Loan ID: {{.LoanId}}
+Lender ID: {{.InvestorId}}
+Participation: {{.Currency}} {{.InvestedAmount}} of {{.Currency}} {{.PrincipalAmount}}
+Return on investment: {{.ROI}}% per annum
+Tenor: {{.TenorMonths}} month(s)
+
+The lender funds the participation above and receives its pro rata share of every repayment of the loan.
+The lender bears its pro rata share of any loss should the loan be written off.
+`)),
+	},
+}
+
+// agreementData is the data agreement templates are rendered with.
+type agreementData struct {
+	Version         string
+	Date            string
+	LoanId          uint64
+	BorrowerId      uint64
+	Currency        string
+	PrincipalAmount money.Amount
+	InterestRate    float64
+	ROI             float64
+	TenorMonths     uint32
+	LenderCount     int
+	InvestorId      uint64
+	InvestedAmount  money.Amount
+}
+
+// renderAgreement renders an agreement from the template of the given version and type.
+func renderAgreement(version string, agreementType constant.LoanAgreementType, data agreementData) (content []byte, err error) {
+	tmpl, ok := agreementTemplates[version][agreementType]
+	if !ok {
+		return nil, fmt.Errorf("no %s agreement template for version %q", agreementType, version)
+	}
+
+	data.Version = version
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return
+	}
+
+	return buf.Bytes(), nil
+}
+
+// lenderHoldings sums the active investments of a loan per investor, in order of first investment.
+func lenderHoldings(investments []*model.LoanInvestment) (investorIds []uint64, amounts map[uint64]money.Amount) {
+	amounts = make(map[uint64]money.Amount)
+	for _, investment := range investments {
+		if _, ok := amounts[investment.InvestorId]; !ok {
+			investorIds = append(investorIds, investment.InvestorId)
+		}
+		amounts[investment.InvestorId] += investment.Amount
+	}
+
+	return
+}
+
+// generateAgreements renders, stores and records the borrower agreement of a fully invested loan and one lender agreement
+// per investor, then points the agreement link of the loan to the borrower agreement. The caller persists the loan.
+// Documents are stored under deterministic keys, so a retry after a rolled back transaction overwrites them.
+func (s *service) generateAgreements(ctx context.Context, loan *model.Loan, createdBy uint64, tx *sqlx.Tx) (err error) {
+	// Get investments.
+	investments, err := s.repository.db.GetInvestmentsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	investorIds, amounts := lenderHoldings(investments)
+
+	data := agreementData{
+		Date:            now().Format(time.DateOnly),
+		LoanId:          loan.Id,
+		BorrowerId:      loan.BorrowerId,
+		Currency:        loan.Currency,
+		PrincipalAmount: loan.PrincipalAmount,
+		InterestRate:    loan.InterestRate,
+		ROI:             loan.ROI,
+		TenorMonths:     loan.TenorMonths,
+		LenderCount:     len(investorIds),
+	}
+
+	// Generate borrower agreement.
+	key := fmt.Sprintf("loans/%d/agreements/borrower-%s.txt", loan.Id, agreementTemplateVersion)
+	link, err := s.storeAgreement(ctx, loan.Id, constant.LoanAgreementTypeBorrower, sql.NullInt64{}, key, data, createdBy, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	loan.AgreementLink = &link
+
+	// Generate lender agreements.
+	for _, investorId := range investorIds {
+		data.InvestorId = investorId
+		data.InvestedAmount = amounts[investorId]
+		key = fmt.Sprintf("loans/%d/agreements/lender-%d-%s.txt", loan.Id, investorId, agreementTemplateVersion)
+		_, err = s.storeAgreement(ctx, loan.Id, constant.LoanAgreementTypeLender, sql.NullInt64{Int64: int64(investorId), Valid: true}, key, data, createdBy, tx)
+		if err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+	}
+
+	return
+}
+
+// storeAgreement renders a single agreement, writes it to the document store and records it.
+func (s *service) storeAgreement(ctx context.Context, loanId uint64, agreementType constant.LoanAgreementType, investorId sql.NullInt64, key string, data agreementData, createdBy uint64, tx *sqlx.Tx) (link string, err error) {
+	content, err := renderAgreement(agreementTemplateVersion, agreementType, data)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	link, err = s.repository.document.PutDocument(ctx, key, content)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	err = s.repository.db.CreateLoanAgreement(ctx, &model.LoanAgreement{
+		LoanId:          loanId,
+		Type:            agreementType,
+		InvestorId:      investorId,
+		TemplateVersion: agreementTemplateVersion,
+		DocumentLink:    link,
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(createdBy), Valid: true},
+		},
+	}, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// validateSignedAgreement fails unless link is the borrower agreement generated for the loan.
+func validateSignedAgreement(loan *model.Loan, link string) error {
+	if loan.AgreementLink == nil || *loan.AgreementLink == "" {
+		return constant.ErrAgreementNotGenerated
+	}
+	if *loan.AgreementLink != link {
+		return constant.ErrAgreementMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderAgreement(t *testing.T) {
+	data := agreementData{
+		Date:            "2025-01-31",
+		LoanId:          7,
+		BorrowerId:      3,
+		Currency:        "IDR",
+		PrincipalAmount: 5_000_000 * money.Unit,
+		InterestRate:    12.5,
+		ROI:             10,
+		TenorMonths:     12,
+		LenderCount:     2,
+		InvestorId:      9,
+		InvestedAmount:  1_000_000 * money.Unit,
+	}
+
+	borrower, err := renderAgreement(agreementTemplateVersion, constant.LoanAgreementTypeBorrower, data)
+	assert.NoError(t, err)
+	assert.Contains(t, string(borrower), "Template version: v1")
+	assert.Contains(t, string(borrower), "Borrower ID: 3")
+	assert.Contains(t, string(borrower), "Principal: IDR 5000000.00")
+	assert.Contains(t, string(borrower), "Interest rate: 12.5% per annum")
+
+	lender, err := renderAgreement(agreementTemplateVersion, constant.LoanAgreementTypeLender, data)
+	assert.NoError(t, err)
+	assert.Contains(t, string(lender), "Lender ID: 9")
+	assert.Contains(t, string(lender), "Participation: IDR 1000000.00 of IDR 5000000.00")
+
+	_, err = renderAgreement("v0", constant.LoanAgreementTypeBorrower, data)
+	assert.Error(t, err)
+}
+
+func TestLenderHoldings(t *testing.T) {
+	investments := []*model.LoanInvestment{
+		{InvestorId: 2, Amount: 100 * money.Unit},
+		{InvestorId: 1, Amount: 50 * money.Unit},
+		{InvestorId: 2, Amount: 25 * money.Unit},
+	}
+
+	investorIds, amounts := lenderHoldings(investments)
+	assert.Equal(t, []uint64{2, 1}, investorIds)
+	assert.Equal(t, map[uint64]money.Amount{2: 125 * money.Unit, 1: 50 * money.Unit}, amounts)
+}
+
+func TestValidateSignedAgreement(t *testing.T) {
+	link := "file:///documents/loans/7/agreements/borrower-v1.txt"
+
+	tm := []struct {
+		name    string
+		loan    *model.Loan
+		link    string
+		wantErr error
+	}{
+		{
+			name:    "matches",
+			loan:    &model.Loan{AgreementLink: &link},
+			link:    link,
+			wantErr: nil,
+		},
+		{
+			name:    "mismatch",
+			loan:    &model.Loan{AgreementLink: &link},
+			link:    "https://example.com/agreement.pdf",
+			wantErr: constant.ErrAgreementMismatch,
+		},
+		{
+			name:    "notGenerated",
+			loan:    &model.Loan{},
+			link:    link,
+			wantErr: constant.ErrAgreementNotGenerated,
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, validateSignedAgreement(tt.loan, tt.link))
+		})
+	}
+}
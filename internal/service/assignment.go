@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/statemachine"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// assignableLoanStates are the states in which a loan awaits the review of a field validator.
+var assignableLoanStates = statemachine.Loan.Sources(statemachine.EventApprove)
+
+// assignmentStrategy returns the configured assignment strategy, falling back to the default one.
+func (s *service) assignmentStrategy() constant.LoanAssignmentStrategy {
+	switch strategy := constant.LoanAssignmentStrategy(s.config.Loan.AssignmentStrategy); strategy {
+	case constant.LoanAssignmentStrategyRoundRobin, constant.LoanAssignmentStrategyLeastLoaded:
+		return strategy
+	}
+
+	return constant.DefaultLoanAssignmentStrategy
+}
+
+// pickValidator chooses the validator a loan is assigned to among workloads, sorted by validator ID.
+// Round robin takes the validator after lastValidatorId, wrapping around. It returns zero without any validator.
+func pickValidator(strategy constant.LoanAssignmentStrategy, workloads []*model.ValidatorWorkload, lastValidatorId uint64) uint64 {
+	if len(workloads) == 0 {
+		return 0
+	}
+
+	if strategy == constant.LoanAssignmentStrategyRoundRobin {
+		for _, workload := range workloads {
+			if workload.ValidatorId > lastValidatorId {
+				return workload.ValidatorId
+			}
+		}
+		return workloads[0].ValidatorId
+	}
+
+	picked := workloads[0]
+	for _, workload := range workloads[1:] {
+		if workload.OpenAssignments < picked.OpenAssignments {
+			picked = workload
+		}
+	}
+	return picked.ValidatorId
+}
+
+// nextStepNeedsValidator reports whether the approval step following the done ones is a field validator's.
+func nextStepNeedsValidator(steps []uint8, done int) bool {
+	return done < len(steps) && steps[done] == constant.RoleIdFieldValidator
+}
+
+// awaitsValidator reports whether the next approval step of a queued loan is a field validator's.
+func (s *service) awaitsValidator(loan *model.QueuedLoan) (ok bool, err error) {
+	currency, err := s.currency(loan.Currency)
+	if err != nil {
+		return
+	}
+
+	return nextStepNeedsValidator(currency.approvalSteps(loan.PrincipalAmount), int(loan.ApprovalCount)), nil
+}
+
+// assignNextValidator assigns a loan to a field validator when its next approval step needs one, skipping the users who
+// already approved it. The loan stays in the queue when no validator is available, in which case assignment is nil.
+func (s *service) assignNextValidator(ctx context.Context, loan *model.Loan, approvals []*model.LoanApproval, assignedBy sql.NullInt64, tx *sqlx.Tx) (assignment *model.LoanAssignment, err error) {
+	// Validate the next approval step.
+	currency, err := s.currency(loan.Currency)
+	if err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if !nextStepNeedsValidator(currency.approvalSteps(loan.PrincipalAmount), len(approvals)) {
+		return nil, nil
+	}
+
+	// Get active validators, except the ones who already approved the loan.
+	workloads, err := s.repository.db.GetValidatorWorkloads(ctx, assignableLoanStates, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	workloads = slices.DeleteFunc(workloads, func(workload *model.ValidatorWorkload) bool {
+		return slices.ContainsFunc(approvals, func(approval *model.LoanApproval) bool {
+			return approval.ValidatorId == workload.ValidatorId
+		})
+	})
+
+	// Pick validator.
+	strategy := s.assignmentStrategy()
+	var lastValidatorId uint64
+	if strategy == constant.LoanAssignmentStrategyRoundRobin {
+		if lastValidatorId, err = s.repository.db.GetLastAssignedValidatorId(ctx, tx); err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+	}
+	validatorId := pickValidator(strategy, workloads, lastValidatorId)
+	if validatorId == 0 {
+		util.LogContext(ctx).Warn(fmt.Sprintf("No field validator available for loan ID %d, it stays in the queue", loan.Id))
+		return nil, nil
+	}
+
+	// Create loan assignment.
+	assignment = &model.LoanAssignment{
+		LoanId:      loan.Id,
+		ValidatorId: validatorId,
+		Status:      constant.LoanAssignmentStatusAssigned,
+		AssignedAt:  now(),
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: assignedBy,
+			UpdatedAt: sql.NullTime{Time: now(), Valid: true},
+			UpdatedBy: assignedBy,
+		},
+	}
+	if err = s.repository.db.CreateLoanAssignment(ctx, assignment, tx); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// closeLoanAssignment closes the given assignment, if any, with the given status.
+func (s *service) closeLoanAssignment(ctx context.Context, assignment *model.LoanAssignment, status constant.LoanAssignmentStatus, closedBy uint64, tx *sqlx.Tx) (err error) {
+	if assignment == nil {
+		return
+	}
+
+	assignment.Status = status
+	assignment.ClosedAt = sql.NullTime{Time: now(), Valid: true}
+	assignment.UpdatedBy = sql.NullInt64{Int64: int64(closedBy), Valid: true}
+	if err = s.repository.db.UpdateLoanAssignment(ctx, assignment, tx); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+func (s *service) AssignLoans(ctx context.Context) (res *model.AssignLoansResponse, err error) {
+	// Get queued loans.
+	loans, err := s.repository.db.GetUnassignedLoans(ctx, assignableLoanStates)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	res = &model.AssignLoansResponse{}
+	for _, loan := range loans {
+		// Skip loans awaiting a step no field validator takes, without opening a transaction.
+		awaits, errAwaits := s.awaitsValidator(loan)
+		if errAwaits != nil {
+			util.LogContext(ctx).Warn(fmt.Sprintf("Failed to assign loan ID %d: %s", loan.LoanId, errAwaits.Error()))
+			continue
+		}
+		if !awaits {
+			continue
+		}
+
+		assigned, errAssign := s.assignQueuedLoan(ctx, loan.LoanId)
+		if errAssign != nil {
+			util.LogContext(ctx).Error(fmt.Sprintf("Failed to assign loan ID %d: %s", loan.LoanId, errAssign.Error()))
+			continue
+		}
+		if assigned {
+			res.AssignedLoanCount++
+		}
+	}
+
+	return
+}
+
+// assignQueuedLoan assigns a single queued loan within a serializable transaction.
+func (s *service) assignQueuedLoan(ctx context.Context, loanId uint64) (assigned bool, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, loanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Re-validate loan state and assignment within the tx.
+	if !slices.Contains(assignableLoanStates, loan.State) {
+		return false, nil
+	}
+	open, err := s.repository.db.GetOpenLoanAssignment(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if open != nil {
+		return false, nil
+	}
+
+	// Assign loan.
+	approvals, err := s.repository.db.GetLoanApprovalsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	assignment, err := s.assignNextValidator(ctx, loan, approvals, sql.NullInt64{}, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return assignment != nil, nil
+}
+
+func (s *service) ListMyAssignments(ctx context.Context, req *model.ListMyAssignmentsRequest) (res *model.ListMyAssignmentsResponse, err error) {
+	// Get pending assignments.
+	assignments, err := s.repository.db.GetPendingAssignments(ctx, req.ValidatorId, assignableLoanStates)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.ListMyAssignmentsResponse{
+		Assignments: assignments,
+	}
+
+	return
+}
+
+func (s *service) ReassignLoan(ctx context.Context, req *model.ReassignLoanRequest) (res *model.ReassignLoanResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan state.
+	if !slices.Contains(assignableLoanStates, loan.State) {
+		err = constant.ErrLoanNotAssignable
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate assignee.
+	users, err := s.repository.db.GetUserByIds(ctx, []uint64{req.ValidatorId}, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if len(users) == 0 || users[0].RoleId != constant.RoleIdFieldValidator || users[0].Status != constant.UserStatusActive {
+		err = constant.ErrInvalidAssignee
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	approvals, err := s.repository.db.GetLoanApprovalsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if slices.ContainsFunc(approvals, func(approval *model.LoanApproval) bool {
+		return approval.ValidatorId == req.ValidatorId
+	}) {
+		err = constant.ErrDuplicateApprover
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate the next approval step.
+	currency, err := s.currency(loan.Currency)
+	if err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if !nextStepNeedsValidator(currency.approvalSteps(loan.PrincipalAmount), len(approvals)) {
+		err = constant.ErrLoanNotAssignable
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get current assignment, reassigning to the same validator changes nothing.
+	open, err := s.repository.db.GetOpenLoanAssignment(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if open != nil && open.ValidatorId == req.ValidatorId {
+		res = &model.ReassignLoanResponse{
+			Assignment: open,
+		}
+		return
+	}
+
+	// Close current assignment.
+	if err = s.closeLoanAssignment(ctx, open, constant.LoanAssignmentStatusReassigned, req.AdminId, tx); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Create loan assignment.
+	assignment := &model.LoanAssignment{
+		LoanId:      loan.Id,
+		ValidatorId: req.ValidatorId,
+		Status:      constant.LoanAssignmentStatusAssigned,
+		AssignedAt:  now(),
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.AdminId), Valid: true},
+			UpdatedAt: sql.NullTime{Time: now(), Valid: true},
+			UpdatedBy: sql.NullInt64{Int64: int64(req.AdminId), Valid: true},
+		},
+	}
+	if err = s.repository.db.CreateLoanAssignment(ctx, assignment, tx); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.ReassignLoanResponse{
+		Assignment: assignment,
+	}
+
+	return
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickValidator(t *testing.T) {
+	workloads := []*model.ValidatorWorkload{
+		{ValidatorId: 3, OpenAssignments: 4},
+		{ValidatorId: 7, OpenAssignments: 1},
+		{ValidatorId: 9, OpenAssignments: 1},
+	}
+
+	tm := []struct {
+		name            string
+		strategy        constant.LoanAssignmentStrategy
+		workloads       []*model.ValidatorWorkload
+		lastValidatorId uint64
+		want            uint64
+	}{
+		{
+			name:     "noValidator",
+			strategy: constant.LoanAssignmentStrategyLeastLoaded,
+			want:     0,
+		},
+		{
+			name:      "leastLoadedLowestIdOnTie",
+			strategy:  constant.LoanAssignmentStrategyLeastLoaded,
+			workloads: workloads,
+			want:      7,
+		},
+		{
+			name:            "roundRobinFirstAssignment",
+			strategy:        constant.LoanAssignmentStrategyRoundRobin,
+			workloads:       workloads,
+			lastValidatorId: 0,
+			want:            3,
+		},
+		{
+			name:            "roundRobinNextValidator",
+			strategy:        constant.LoanAssignmentStrategyRoundRobin,
+			workloads:       workloads,
+			lastValidatorId: 3,
+			want:            7,
+		},
+		{
+			name:            "roundRobinSkipsRemovedValidator",
+			strategy:        constant.LoanAssignmentStrategyRoundRobin,
+			workloads:       workloads,
+			lastValidatorId: 8,
+			want:            9,
+		},
+		{
+			name:            "roundRobinWrapsAround",
+			strategy:        constant.LoanAssignmentStrategyRoundRobin,
+			workloads:       workloads,
+			lastValidatorId: 9,
+			want:            3,
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, pickValidator(tt.strategy, tt.workloads, tt.lastValidatorId))
+		})
+	}
+}
+
+func TestNextStepNeedsValidator(t *testing.T) {
+	steps := []uint8{constant.RoleIdFieldValidator, constant.RoleIdAdmin, constant.RoleIdFieldValidator}
+
+	assert.True(t, nextStepNeedsValidator(steps, 0))
+	assert.False(t, nextStepNeedsValidator(steps, 1))
+	assert.True(t, nextStepNeedsValidator(steps, 2))
+	assert.False(t, nextStepNeedsValidator(steps, 3))
+}
+
+func TestAssignmentStrategy(t *testing.T) {
+	for strategy, want := range map[string]constant.LoanAssignmentStrategy{
+		"":             constant.DefaultLoanAssignmentStrategy,
+		"ROUND_ROBIN":  constant.LoanAssignmentStrategyRoundRobin,
+		"LEAST_LOADED": constant.LoanAssignmentStrategyLeastLoaded,
+		"RANDOM":       constant.DefaultLoanAssignmentStrategy,
+	} {
+		s := &service{config: &model.AppConfig{Loan: model.LoanConfig{AssignmentStrategy: strategy}}}
+		assert.Equal(t, want, s.assignmentStrategy())
+	}
+}
+
+func TestAwaitsValidator(t *testing.T) {
+	s := &service{config: &model.AppConfig{Loan: model.LoanConfig{
+		ApprovalTiers: []model.ApprovalTierConfig{
+			{Threshold: 100_000_000, Steps: []uint8{constant.RoleIdFieldValidator, constant.RoleIdAdmin}},
+		},
+	}}}
+
+	tm := []struct {
+		name    string
+		loan    *model.QueuedLoan
+		want    bool
+		wantErr error
+	}{
+		{name: "singleStep", loan: &model.QueuedLoan{PrincipalAmount: 5_000_000 * money.Unit}, want: true},
+		{name: "firstOfTier", loan: &model.QueuedLoan{PrincipalAmount: 200_000_000 * money.Unit}, want: true},
+		{name: "awaitingAdmin", loan: &model.QueuedLoan{PrincipalAmount: 200_000_000 * money.Unit, ApprovalCount: 1}},
+		{name: "unsupportedCurrency", loan: &model.QueuedLoan{Currency: "USD"}, wantErr: constant.ErrUnsupportedCurrency},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.awaitsValidator(tt.loan)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// matchesAutoInvestRule reports whether a loan satisfies the criteria of an auto invest rule.
+func matchesAutoInvestRule(rule *model.AutoInvestRule, loan *model.Loan) bool {
+	if rule.Currency != loan.Currency || loan.ROI < rule.MinROI {
+		return false
+	}
+	if rule.MaxPrincipal > 0 && loan.PrincipalAmount > rule.MaxPrincipal {
+		return false
+	}
+	if rule.MaxTenorMonths > 0 && loan.TenorMonths > rule.MaxTenorMonths {
+		return false
+	}
+	if rule.MaxRiskGrade != "" && (loan.RiskGrade == "" || loan.RiskGrade > rule.MaxRiskGrade) {
+		return false
+	}
+
+	return true
+}
+
+// autoInvest turns the auto invest rules matching a newly approved loan into investments.
+// Rules take turns in round-robin order and invest through InvestInLoan, so every amount, concentration and funding limit applies.
+// A rule failing a limit is skipped and the loan is offered to the next one, an investor invests at most once whatever its number of rules.
+func (s *service) autoInvest(ctx context.Context, loanId uint64) {
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, loanId, nil)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Get rules of the loan currency in round-robin order.
+	rules, err := s.repository.db.GetAutoInvestRulesByCurrency(ctx, loan.Currency, nil)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	invested := make(map[uint64]bool)
+	for _, rule := range rules {
+		if loan.InvestedAmount >= loan.PrincipalAmount {
+			return
+		}
+		if invested[rule.InvestorId] || !matchesAutoInvestRule(rule, loan) {
+			continue
+		}
+
+		// Invest on behalf of the investor, never more than what is left to fund.
+		res, err := s.InvestInLoan(ctx, &model.InvestInLoanRequest{
+			LoanId:     loan.Id,
+			InvestorId: rule.InvestorId,
+			RoleId:     constant.RoleIdInvestor,
+			Amount:     min(rule.AmountPerLoan, loan.PrincipalAmount-loan.InvestedAmount),
+			Currency:   loan.Currency,
+		})
+		if errors.Is(err, constant.ErrIllegalLoanTransition) {
+			// The loan is no longer open for funding.
+			util.LogContext(ctx).Warn(err.Error())
+			return
+		}
+		if err != nil {
+			util.LogContext(ctx).Warn(fmt.Sprintf("auto invest rule %d skipped: %s", rule.Id, err.Error()))
+			continue
+		}
+		invested[rule.InvestorId] = true
+		loan.InvestedAmount += res.InvestedAmount
+
+		// Move the rule to the back of the round-robin.
+		if err = s.repository.db.SetAutoInvestRuleInvestedAt(ctx, rule.Id, now(), nil); err != nil {
+			util.LogContext(ctx).Error(err.Error())
+		}
+	}
+}
+
+// CreateAutoInvestRule saves a rule investing automatically in the approved loans matching its criteria.
+func (s *service) CreateAutoInvestRule(ctx context.Context, req *model.CreateAutoInvestRuleRequest) (res *model.CreateAutoInvestRuleResponse, err error) {
+	// Validate currency and amount per loan.
+	currency, err := s.currency(req.Currency)
+	if err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if !currency.validInvestment(req.AmountPerLoan) {
+		err = constant.ErrInvestmentAmountOutOfRange
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Prepare rule model.
+	rule := &model.AutoInvestRule{
+		InvestorId:     req.InvestorId,
+		Currency:       currency.code,
+		AmountPerLoan:  req.AmountPerLoan,
+		MinROI:         req.MinROI,
+		MaxPrincipal:   req.MaxPrincipal,
+		MaxTenorMonths: req.MaxTenorMonths,
+		MaxRiskGrade:   constant.LoanRiskGrade(req.MaxRiskGrade),
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.InvestorId), Valid: true},
+			UpdatedAt: sql.NullTime{Time: now(), Valid: true},
+			UpdatedBy: sql.NullInt64{Int64: int64(req.InvestorId), Valid: true},
+		},
+	}
+
+	// Create rule.
+	err = s.repository.db.CreateAutoInvestRule(ctx, rule, nil)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.CreateAutoInvestRuleResponse{
+		Rule: rule,
+	}
+
+	return
+}
+
+// ListAutoInvestRules returns the auto invest rules of an investor.
+func (s *service) ListAutoInvestRules(ctx context.Context, req *model.ListAutoInvestRulesRequest) (res *model.ListAutoInvestRulesResponse, err error) {
+	// Get rules of the investor.
+	rules, err := s.repository.db.GetAutoInvestRulesByInvestorId(ctx, req.InvestorId, nil)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.ListAutoInvestRulesResponse{
+		Rules: rules,
+	}
+
+	return
+}
+
+// DeleteAutoInvestRule stops an auto invest rule, investments it already placed are kept.
+func (s *service) DeleteAutoInvestRule(ctx context.Context, req *model.DeleteAutoInvestRuleRequest) (res *model.DeleteAutoInvestRuleResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get rule by ID.
+	rule, err := s.repository.db.GetAutoInvestRuleById(ctx, req.RuleId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Rules of other investors are reported as not found.
+	if rule.InvestorId != req.InvestorId {
+		err = constant.ErrAutoInvestRuleNotFound
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Delete rule.
+	rule.DeletedAt = sql.NullTime{Time: now(), Valid: true}
+	rule.DeletedBy = sql.NullInt64{Int64: int64(req.InvestorId), Valid: true}
+	err = s.repository.db.DeleteAutoInvestRule(ctx, rule, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.DeleteAutoInvestRuleResponse{
+		RuleId:    rule.Id,
+		DeletedAt: rule.DeletedAt.Time,
+	}
+
+	return
+}
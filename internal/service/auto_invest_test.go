@@ -0,0 +1,83 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesAutoInvestRule(t *testing.T) {
+	loan := &model.Loan{
+		PrincipalAmount: 5_000_000 * money.Unit,
+		Currency:        "IDR",
+		ROI:             12,
+		TenorMonths:     12,
+		RiskGrade:       constant.LoanRiskGradeB,
+	}
+
+	tm := []struct {
+		name string
+		rule model.AutoInvestRule
+		loan *model.Loan
+		want bool
+	}{
+		{
+			name: "anyLoan",
+			rule: model.AutoInvestRule{Currency: "IDR"},
+			loan: loan,
+			want: true,
+		},
+		{
+			name: "allCriteriaMet",
+			rule: model.AutoInvestRule{Currency: "IDR", MinROI: 12, MaxPrincipal: 5_000_000 * money.Unit, MaxTenorMonths: 12, MaxRiskGrade: constant.LoanRiskGradeB},
+			loan: loan,
+			want: true,
+		},
+		{
+			name: "otherCurrency",
+			rule: model.AutoInvestRule{Currency: "USD"},
+			loan: loan,
+		},
+		{
+			name: "roiTooLow",
+			rule: model.AutoInvestRule{Currency: "IDR", MinROI: 12.5},
+			loan: loan,
+		},
+		{
+			name: "principalTooHigh",
+			rule: model.AutoInvestRule{Currency: "IDR", MaxPrincipal: 4_999_999 * money.Unit},
+			loan: loan,
+		},
+		{
+			name: "tenorTooLong",
+			rule: model.AutoInvestRule{Currency: "IDR", MaxTenorMonths: 6},
+			loan: loan,
+		},
+		{
+			name: "riskGradeTooHigh",
+			rule: model.AutoInvestRule{Currency: "IDR", MaxRiskGrade: constant.LoanRiskGradeA},
+			loan: loan,
+		},
+		{
+			name: "riskGradeLower",
+			rule: model.AutoInvestRule{Currency: "IDR", MaxRiskGrade: constant.LoanRiskGradeE},
+			loan: loan,
+			want: true,
+		},
+		{
+			name: "ungradedLoanWithRiskGrade",
+			rule: model.AutoInvestRule{Currency: "IDR", MaxRiskGrade: constant.LoanRiskGradeE},
+			loan: &model.Loan{PrincipalAmount: loan.PrincipalAmount, Currency: "IDR", ROI: 12, TenorMonths: 12},
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchesAutoInvestRule(&tt.rule, tt.loan))
+		})
+	}
+}
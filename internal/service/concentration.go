@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// exceedsLoanShare reports whether holding is more than maxShare percent of principal. A zero maxShare disables the limit.
+func exceedsLoanShare(holding, principal money.Amount, maxShare float64) bool {
+	if maxShare <= 0 {
+		return false
+	}
+
+	limit := new(big.Rat).Mul(principal.Rat(), new(big.Rat).Quo(decimalRat(maxShare), big.NewRat(100, 1))) //nolint
+	return holding.Rat().Cmp(limit) > 0
+}
+
+// checkConcentration fails when investing amount in loan would make the investor hold more than the configured share of the loan,
+// or more than the configured exposure to the borrower of the loan across its portfolio.
+func (s *service) checkConcentration(ctx context.Context, loan *model.Loan, investorId uint64, amount money.Amount, currency currencyLimits, tx *sqlx.Tx) (err error) {
+	// Validate share of the loan.
+	if maxShare := s.config.Loan.MaxLoanShare; maxShare > 0 {
+		var holding money.Amount
+		holding, err = s.repository.db.GetInvestorLoanHolding(ctx, loan.Id, investorId, tx)
+		if err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+		if exceedsLoanShare(holding+amount, loan.PrincipalAmount, maxShare) {
+			return constant.ErrLoanShareExceeded
+		}
+	}
+
+	// Validate exposure to the borrower.
+	if currency.maxExposure > 0 {
+		var exposure money.Amount
+		exposure, err = s.repository.db.GetInvestorBorrowerExposure(ctx, investorId, loan.BorrowerId, currency.code, closedLoanStates, tx)
+		if err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+		if exposure+amount > currency.maxExposure {
+			return constant.ErrBorrowerExposureExceeded
+		}
+	}
+
+	return
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExceedsLoanShare(t *testing.T) {
+	tm := []struct {
+		name      string
+		holding   money.Amount
+		principal money.Amount
+		maxShare  float64
+		want      bool
+	}{
+		{name: "belowShare", holding: 2_000_000 * money.Unit, principal: 10_000_000 * money.Unit, maxShare: 25, want: false},
+		{name: "exactShare", holding: 2_500_000 * money.Unit, principal: 10_000_000 * money.Unit, maxShare: 25, want: false},
+		{name: "aboveShareByOneCent", holding: 2_500_000*money.Unit + 1, principal: 10_000_000 * money.Unit, maxShare: 25, want: true},
+		{name: "fractionalShare", holding: 333_334, principal: 1_000_000, maxShare: 33.3333, want: true},
+		{name: "disabled", holding: 10_000_000 * money.Unit, principal: 10_000_000 * money.Unit, maxShare: 0, want: false},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, exceedsLoanShare(tt.holding, tt.principal, tt.maxShare))
+		})
+	}
+}
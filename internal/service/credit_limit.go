@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// closedLoanStates are the states whose principal no longer counts against the credit limit of the borrower.
+// Written off loans keep counting since their principal was never repaid.
+var closedLoanStates = []constant.LoanState{
+	constant.LoanStateRejected,
+	constant.LoanStateCancelled,
+	constant.LoanStateExpired,
+	constant.LoanStateClosed,
+}
+
+// creditLimit returns the credit limit of a borrower in a currency, falling back to the default limit of the currency.
+// The returned record is nil when the default applies.
+func (s *service) creditLimit(ctx context.Context, borrowerId uint64, currency currencyLimits, tx *sqlx.Tx) (limit money.Amount, record *model.BorrowerCreditLimit, err error) {
+	record, err = s.repository.db.GetBorrowerCreditLimit(ctx, borrowerId, currency.code, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if record == nil {
+		return currency.creditLimit, nil, nil
+	}
+
+	return record.LimitAmount, record, nil
+}
+
+// checkCreditLimit fails with ErrCreditLimitExceeded when the open loans of a borrower plus additional exceed its credit limit.
+func (s *service) checkCreditLimit(ctx context.Context, borrowerId uint64, currency currencyLimits, additional money.Amount, tx *sqlx.Tx) (err error) {
+	limit, _, err := s.creditLimit(ctx, borrowerId, currency, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	exposure, err := s.repository.db.GetBorrowerExposure(ctx, borrowerId, currency.code, closedLoanStates, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	if exposure+additional > limit {
+		return constant.ErrCreditLimitExceeded
+	}
+
+	return
+}
+
+// validateBorrower fails unless userId is an existing borrower.
+func (s *service) validateBorrower(ctx context.Context, userId uint64, tx *sqlx.Tx) (err error) {
+	users, err := s.repository.db.GetUserByIds(ctx, []uint64{userId}, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if len(users) == 0 {
+		return constant.ErrUserNotFound
+	}
+	if users[0].RoleId != constant.RoleIdBorrower {
+		return constant.ErrNotABorrower
+	}
+
+	return
+}
+
+// GetBorrowerCreditLimit returns the credit limit of a borrower and how much of it is used by open loans.
+func (s *service) GetBorrowerCreditLimit(ctx context.Context, req *model.GetBorrowerCreditLimitRequest) (res *model.GetBorrowerCreditLimitResponse, err error) {
+	// Validate currency.
+	currency, err := s.currency(req.Currency)
+	if err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		ReadOnly: true,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Validate borrower.
+	if err = s.validateBorrower(ctx, req.BorrowerId, tx); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get credit limit.
+	limit, record, err := s.creditLimit(ctx, req.BorrowerId, currency, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Get exposure.
+	exposure, err := s.repository.db.GetBorrowerExposure(ctx, req.BorrowerId, currency.code, closedLoanStates, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.GetBorrowerCreditLimitResponse{
+		BorrowerId:      req.BorrowerId,
+		Currency:        currency.code,
+		LimitAmount:     limit,
+		IsDefault:       record == nil,
+		ExposureAmount:  exposure,
+		AvailableAmount: max(limit-exposure, 0),
+	}
+	if record != nil {
+		res.UpdatedAt = record.UpdatedAt.Time
+	}
+
+	return
+}
+
+// SetBorrowerCreditLimit sets the credit limit of a borrower and records the change in the audit trail.
+// Lowering a limit below the current exposure is allowed, it only blocks new loans and approvals.
+func (s *service) SetBorrowerCreditLimit(ctx context.Context, req *model.SetBorrowerCreditLimitRequest) (res *model.SetBorrowerCreditLimitResponse, err error) {
+	// Validate currency.
+	currency, err := s.currency(req.Currency)
+	if err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Validate borrower.
+	if err = s.validateBorrower(ctx, req.BorrowerId, tx); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get current credit limit.
+	previous, record, err := s.creditLimit(ctx, req.BorrowerId, currency, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Prepare audit model.
+	audit := &model.BorrowerCreditLimitAudit{
+		BorrowerId: req.BorrowerId,
+		Currency:   currency.code,
+		NewLimit:   req.LimitAmount,
+		Reason:     req.Reason,
+		ChangedBy:  req.AdminId,
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.AdminId), Valid: true},
+		},
+	}
+	if record != nil {
+		audit.PreviousLimit = &record.LimitAmount
+	}
+
+	// Set credit limit.
+	limit := &model.BorrowerCreditLimit{
+		BorrowerId:  req.BorrowerId,
+		Currency:    currency.code,
+		LimitAmount: req.LimitAmount,
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.AdminId), Valid: true},
+			UpdatedAt: sql.NullTime{Time: now(), Valid: true},
+			UpdatedBy: sql.NullInt64{Int64: int64(req.AdminId), Valid: true},
+		},
+	}
+	err = s.repository.db.UpsertBorrowerCreditLimit(ctx, limit, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Record the change.
+	err = s.repository.db.CreateBorrowerCreditLimitAudit(ctx, audit, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Get exposure.
+	exposure, err := s.repository.db.GetBorrowerExposure(ctx, req.BorrowerId, currency.code, closedLoanStates, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.SetBorrowerCreditLimitResponse{
+		BorrowerId:      req.BorrowerId,
+		Currency:        currency.code,
+		PreviousLimit:   previous,
+		LimitAmount:     limit.LimitAmount,
+		ExposureAmount:  exposure,
+		AvailableAmount: max(limit.LimitAmount-exposure, 0),
+		UpdatedAt:       limit.UpdatedAt.Time,
+	}
+
+	return
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+)
+
+// currencyLimits are the limits of a supported currency.
+type currencyLimits struct {
+	code          string
+	minPrincipal  money.Amount
+	maxPrincipal  money.Amount
+	minInvestment money.Amount
+	maxInvestment money.Amount
+	lateFee       money.Amount
+	creditLimit   money.Amount
+	maxExposure   money.Amount
+	approvalTiers []model.ApprovalTierConfig
+}
+
+// currency returns the limits of a supported currency, an empty code standing for the default currency.
+// When app.loan.currencies is not configured only the default currency is supported, with the default limits.
+func (s *service) currency(code string) (limits currencyLimits, err error) {
+	if code == "" {
+		code = constant.DefaultCurrency
+	}
+
+	if len(s.config.Loan.Currencies) == 0 {
+		if code != constant.DefaultCurrency {
+			return limits, constant.ErrUnsupportedCurrency
+		}
+
+		return currencyLimits{
+			code:          code,
+			minPrincipal:  constant.MinPrincipalAmount,
+			maxPrincipal:  constant.MaxPrincipalAmount,
+			minInvestment: constant.MinInvestmentAmount,
+			maxInvestment: constant.MaxInvestmentAmount,
+			lateFee:       money.FromFloat(s.config.Loan.LateFee),
+			creditLimit:   constant.DefaultCreditLimit,
+			approvalTiers: s.config.Loan.ApprovalTiers,
+		}, nil
+	}
+
+	for _, c := range s.config.Loan.Currencies {
+		if c.Code != code {
+			continue
+		}
+
+		return currencyLimits{
+			code:          code,
+			minPrincipal:  money.FromFloat(c.MinPrincipal),
+			maxPrincipal:  money.FromFloat(c.MaxPrincipal),
+			minInvestment: money.FromFloat(c.MinInvestment),
+			maxInvestment: money.FromFloat(c.MaxInvestment),
+			lateFee:       money.FromFloat(c.LateFee),
+			creditLimit:   money.FromFloat(c.CreditLimit),
+			maxExposure:   money.FromFloat(c.MaxExposure),
+			approvalTiers: c.ApprovalTiers,
+		}, nil
+	}
+
+	return limits, constant.ErrUnsupportedCurrency
+}
+
+// validPrincipal reports whether amount is within the principal limits of the currency.
+func (c currencyLimits) validPrincipal(amount money.Amount) bool {
+	return amount >= c.minPrincipal && amount <= c.maxPrincipal
+}
+
+// validInvestment reports whether amount is within the investment limits of the currency.
+func (c currencyLimits) validInvestment(amount money.Amount) bool {
+	return amount >= c.minInvestment && amount <= c.maxInvestment
+}
+
+// approvalSteps returns the roles that must approve a loan of the given principal in order. The tier with the highest
+// threshold below the principal applies, loans below every threshold need a single field validator approval.
+func (c currencyLimits) approvalSteps(principal money.Amount) []uint8 {
+	steps := []uint8{constant.RoleIdFieldValidator}
+	var threshold money.Amount
+	for _, tier := range c.approvalTiers {
+		if t := money.FromFloat(tier.Threshold); principal > t && t >= threshold && len(tier.Steps) > 0 {
+			steps, threshold = tier.Steps, t
+		}
+	}
+
+	return steps
+}
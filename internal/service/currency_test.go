@@ -0,0 +1,145 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrency(t *testing.T) {
+	configured := []model.CurrencyConfig{
+		{Code: "IDR", MinPrincipal: 1_000, MaxPrincipal: 100_000_000, MinInvestment: 1_000, MaxInvestment: 100_000_000, LateFee: 50_000, CreditLimit: 500_000_000},
+		{Code: "USD", MinPrincipal: 100, MaxPrincipal: 100_000, MinInvestment: 10, MaxInvestment: 100_000, LateFee: 5.5, CreditLimit: 50_000},
+	}
+
+	tm := []struct {
+		name       string
+		currencies []model.CurrencyConfig
+		code       string
+		want       currencyLimits
+		wantErr    error
+	}{
+		{
+			name: "defaultWhenNotConfigured",
+			code: "",
+			want: currencyLimits{
+				code:          constant.DefaultCurrency,
+				minPrincipal:  constant.MinPrincipalAmount,
+				maxPrincipal:  constant.MaxPrincipalAmount,
+				minInvestment: constant.MinInvestmentAmount,
+				maxInvestment: constant.MaxInvestmentAmount,
+				lateFee:       25_000 * money.Unit,
+				creditLimit:   constant.DefaultCreditLimit,
+			},
+		},
+		{
+			name:    "otherWhenNotConfigured",
+			code:    "USD",
+			wantErr: constant.ErrUnsupportedCurrency,
+		},
+		{
+			name:       "configured",
+			currencies: configured,
+			code:       "USD",
+			want: currencyLimits{
+				code:          "USD",
+				minPrincipal:  100 * money.Unit,
+				maxPrincipal:  100_000 * money.Unit,
+				minInvestment: 10 * money.Unit,
+				maxInvestment: 100_000 * money.Unit,
+				lateFee:       550,
+				creditLimit:   50_000 * money.Unit,
+			},
+		},
+		{
+			name:       "unsupported",
+			currencies: configured,
+			code:       "EUR",
+			wantErr:    constant.ErrUnsupportedCurrency,
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			s := &service{config: &model.AppConfig{Loan: model.LoanConfig{LateFee: 25_000, Currencies: tt.currencies}}}
+			got, err := s.currency(tt.code)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCurrencyLimits(t *testing.T) {
+	limits := currencyLimits{minPrincipal: 100, maxPrincipal: 1_000, minInvestment: 10, maxInvestment: 500}
+
+	assert.True(t, limits.validPrincipal(100))
+	assert.True(t, limits.validPrincipal(1_000))
+	assert.False(t, limits.validPrincipal(99))
+	assert.False(t, limits.validPrincipal(1_001))
+
+	assert.True(t, limits.validInvestment(10))
+	assert.False(t, limits.validInvestment(9))
+	assert.False(t, limits.validInvestment(501))
+}
+
+func TestApprovalSteps(t *testing.T) {
+	limits := currencyLimits{approvalTiers: []model.ApprovalTierConfig{
+		{Threshold: 500_000_000, Steps: []uint8{constant.RoleIdFieldValidator, constant.RoleIdAdmin, constant.RoleIdSuperadmin}},
+		{Threshold: 50_000_000, Steps: []uint8{constant.RoleIdFieldValidator, constant.RoleIdAdmin}},
+		{Threshold: 10_000_000},
+	}}
+
+	tm := []struct {
+		name      string
+		limits    currencyLimits
+		principal money.Amount
+		want      []uint8
+	}{
+		{
+			name:      "notConfigured",
+			principal: 900_000_000 * money.Unit,
+			want:      []uint8{constant.RoleIdFieldValidator},
+		},
+		{
+			name:      "belowEveryThreshold",
+			limits:    limits,
+			principal: 5_000_000 * money.Unit,
+			want:      []uint8{constant.RoleIdFieldValidator},
+		},
+		{
+			name:      "tierWithoutStepsIgnored",
+			limits:    limits,
+			principal: 20_000_000 * money.Unit,
+			want:      []uint8{constant.RoleIdFieldValidator},
+		},
+		{
+			name:      "atThreshold",
+			limits:    limits,
+			principal: 50_000_000 * money.Unit,
+			want:      []uint8{constant.RoleIdFieldValidator},
+		},
+		{
+			name:      "aboveThreshold",
+			limits:    limits,
+			principal: 50_000_000*money.Unit + 1,
+			want:      []uint8{constant.RoleIdFieldValidator, constant.RoleIdAdmin},
+		},
+		{
+			name:      "highestThresholdApplies",
+			limits:    limits,
+			principal: 900_000_000 * money.Unit,
+			want:      []uint8{constant.RoleIdFieldValidator, constant.RoleIdAdmin, constant.RoleIdSuperadmin},
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.limits.approvalSteps(tt.principal))
+		})
+	}
+}
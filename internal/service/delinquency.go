@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/statemachine"
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// delinquencyTrackedLoanStates are the loan states refreshed by the delinquency job, the ones a loan may default from.
+var delinquencyTrackedLoanStates = statemachine.Loan.Sources(statemachine.EventDefault)
+
+// delinquency is the overdue status of a loan at a given date.
+type delinquency struct {
+	daysPastDue   uint32
+	bucket        constant.LoanDelinquencyBucket
+	overdue       []*model.LoanInstallment // Unpaid installments whose due date has passed, oldest first.
+	oldestDueDate time.Time
+}
+
+// assessDelinquency computes days past due from the oldest unpaid installment due before asOf.
+// Due dates are calendar dates, so an installment due today is not overdue yet.
+func assessDelinquency(installments []*model.LoanInstallment, asOf time.Time) (d delinquency) {
+	today := truncateDate(asOf)
+	for _, installment := range installments {
+		if installment.Status == constant.LoanInstallmentStatusPaid {
+			continue
+		}
+
+		dueDate := truncateDate(installment.DueDate)
+		if !dueDate.Before(today) {
+			continue
+		}
+
+		if len(d.overdue) == 0 || dueDate.Before(d.oldestDueDate) {
+			d.oldestDueDate = dueDate
+		}
+		d.overdue = append(d.overdue, installment)
+	}
+
+	if len(d.overdue) > 0 {
+		d.daysPastDue = uint32(today.Sub(d.oldestDueDate).Hours() / 24) //nolint
+	}
+	d.bucket = delinquencyBucket(d.daysPastDue)
+	return
+}
+
+// delinquencyBucket maps days past due to its reporting bucket.
+func delinquencyBucket(daysPastDue uint32) constant.LoanDelinquencyBucket {
+	switch {
+	case daysPastDue == 0:
+		return constant.LoanDelinquencyBucketCurrent
+	case daysPastDue <= 30: //nolint
+		return constant.LoanDelinquencyBucket1To30
+	case daysPastDue <= 60: //nolint
+		return constant.LoanDelinquencyBucket31To60
+	case daysPastDue <= 90: //nolint
+		return constant.LoanDelinquencyBucket61To90
+	default:
+		return constant.LoanDelinquencyBucket90Plus
+	}
+}
+
+// truncateDate drops the time of day, keeping the calendar date in UTC.
+func truncateDate(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// TrackDelinquency refreshes days past due of every disbursed/repaying/defaulted loan and charges late fees on newly overdue installments.
+// Loans past due longer than LoanDefaultDaysPastDue are moved to DEFAULTED.
+// Each loan is processed in its own transaction so one failure does not block the rest of the batch.
+func (s *service) TrackDelinquency(ctx context.Context) (res *model.TrackDelinquencyResponse, err error) {
+	// Get repaying loan IDs.
+	loanIds, err := s.repository.db.GetLoanIdsByStates(ctx, delinquencyTrackedLoanStates)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	res = &model.TrackDelinquencyResponse{
+		DelinquentLoanIds: []uint64{},
+	}
+
+	for _, loanId := range loanIds {
+		loan, charged, firstMissed, errTrack := s.trackLoanDelinquency(ctx, loanId)
+		if errTrack != nil {
+			util.LogContext(ctx).Error(fmt.Sprintf("Failed to track delinquency of loan ID %d: %s", loanId, errTrack.Error()))
+			continue
+		}
+		if loan == nil {
+			continue // Loan has changed since it was listed.
+		}
+
+		res.CheckedLoanCount++
+		res.ChargedLateFeeCount += charged
+		if loan.DaysPastDue > 0 {
+			res.DelinquentLoanIds = append(res.DelinquentLoanIds, loanId)
+		}
+
+		if firstMissed {
+			// Notify investors about the first missed payment.
+			go s.notifyLoanDelinquent(context.Background(), loan)
+		}
+	}
+
+	return
+}
+
+// trackLoanDelinquency refreshes the delinquency of a single loan within a serializable transaction.
+func (s *service) trackLoanDelinquency(ctx context.Context, loanId uint64) (loan *model.Loan, charged uint32, firstMissed bool, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err = s.repository.db.GetLoanById(ctx, loanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Re-validate loan state within the tx.
+	if !slices.Contains(delinquencyTrackedLoanStates, loan.State) {
+		return nil, 0, false, nil
+	}
+
+	// Get installments.
+	installments, err := s.repository.db.GetInstallmentsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Charge late fee once on every overdue installment.
+	checkedAt := now()
+	d := assessDelinquency(installments, checkedAt)
+	// Loans in a currency that is no longer configured are not charged late fees.
+	currency, _ := s.currency(loan.Currency)
+	if lateFee := currency.lateFee; lateFee > 0 {
+		for _, installment := range d.overdue {
+			if installment.LateFeeChargedAt.Valid {
+				continue
+			}
+
+			installment.FeeAmount += lateFee
+			installment.LateFeeChargedAt = sql.NullTime{Time: checkedAt, Valid: true}
+			installment.UpdatedBy = sql.NullInt64{} // Charged by the system.
+			err = s.repository.db.UpdateLoanInstallment(ctx, installment, tx)
+			if err != nil {
+				util.LogContext(ctx).Error(err.Error())
+				return
+			}
+			charged++
+		}
+	}
+
+	// Update loan delinquency.
+	loan.DaysPastDue = d.daysPastDue
+	loan.DelinquencyBucket = d.bucket
+	loan.DelinquencyCheckedAt = sql.NullTime{Time: checkedAt, Valid: true}
+	if d.daysPastDue > 0 && !loan.FirstMissedAt.Valid {
+		loan.FirstMissedAt = sql.NullTime{Time: checkedAt, Valid: true}
+		firstMissed = true
+	}
+	if d.daysPastDue > constant.LoanDefaultDaysPastDue {
+		previous := loan.State
+		if err = statemachine.Loan.Fire(loan, statemachine.EventDefault, constant.RoleIdSystem); err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+		setAccrualEndDate(loan, previous, checkedAt)
+	}
+	loan.UpdatedBy = sql.NullInt64{} // Updated by the system.
+	err = s.repository.db.UpdateLoan(ctx, loan, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return
+}
+
+// GetLoanDelinquency returns the current days past due of a loan along with its overdue amounts.
+func (s *service) GetLoanDelinquency(ctx context.Context, req *model.GetLoanDelinquencyRequest) (res *model.GetLoanDelinquencyResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		ReadOnly: true,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan visibility.
+	if err = s.validateLoanParticipant(ctx, loan, req.UserId, req.RoleId, tx); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get installments.
+	installments, err := s.repository.db.GetInstallmentsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	d := assessDelinquency(installments, now())
+	res = &model.GetLoanDelinquencyResponse{
+		LoanId:               loan.Id,
+		Currency:             loan.Currency,
+		State:                string(loan.State),
+		DaysPastDue:          d.daysPastDue,
+		DelinquencyBucket:    string(d.bucket),
+		OverdueInstallments:  uint32(len(d.overdue)),
+		OldestDueDate:        d.oldestDueDate,
+		DelinquencyCheckedAt: loan.DelinquencyCheckedAt.Time,
+	}
+	for _, installment := range d.overdue {
+		res.OverdueAmount += installmentOutstanding(installment)
+		res.LateFeeAmount += installment.FeeAmount - installment.PaidFee
+	}
+
+	return
+}
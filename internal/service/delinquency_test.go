@@ -0,0 +1,81 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssessDelinquency(t *testing.T) {
+	asOf := time.Date(2025, time.May, 15, 8, 0, 0, 0, time.UTC)
+	schedule := func(statuses ...constant.LoanInstallmentStatus) []*model.LoanInstallment {
+		installments := make([]*model.LoanInstallment, 0, len(statuses))
+		for i, status := range statuses {
+			installments = append(installments, &model.LoanInstallment{
+				InstallmentNumber: uint32(i + 1),
+				DueDate:           time.Date(2025, time.February+time.Month(i), 15, 0, 0, 0, 0, time.UTC),
+				Status:            status,
+			})
+		}
+		return installments
+	}
+
+	tm := []struct {
+		name            string
+		installments    []*model.LoanInstallment
+		wantDaysPastDue uint32
+		wantBucket      constant.LoanDelinquencyBucket
+		wantOverdue     int
+	}{
+		{
+			name:         "current",
+			installments: schedule(constant.LoanInstallmentStatusPaid, constant.LoanInstallmentStatusPaid, constant.LoanInstallmentStatusPaid, constant.LoanInstallmentStatusUnpaid),
+			wantBucket:   constant.LoanDelinquencyBucketCurrent,
+		},
+		{
+			name:            "oneMissed",
+			installments:    schedule(constant.LoanInstallmentStatusPaid, constant.LoanInstallmentStatusPaid, constant.LoanInstallmentStatusPartiallyPaid, constant.LoanInstallmentStatusUnpaid),
+			wantDaysPastDue: 30,
+			wantBucket:      constant.LoanDelinquencyBucket1To30,
+			wantOverdue:     1,
+		},
+		{
+			name:            "allMissed",
+			installments:    schedule(constant.LoanInstallmentStatusUnpaid, constant.LoanInstallmentStatusUnpaid, constant.LoanInstallmentStatusUnpaid, constant.LoanInstallmentStatusUnpaid),
+			wantDaysPastDue: 89,
+			wantBucket:      constant.LoanDelinquencyBucket61To90,
+			wantOverdue:     3,
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			d := assessDelinquency(tt.installments, asOf)
+			assert.Equal(t, tt.wantDaysPastDue, d.daysPastDue)
+			assert.Equal(t, tt.wantBucket, d.bucket)
+			assert.Len(t, d.overdue, tt.wantOverdue)
+		})
+	}
+}
+
+func TestDelinquencyBucket(t *testing.T) {
+	tm := map[uint32]constant.LoanDelinquencyBucket{
+		0:   constant.LoanDelinquencyBucketCurrent,
+		1:   constant.LoanDelinquencyBucket1To30,
+		30:  constant.LoanDelinquencyBucket1To30,
+		31:  constant.LoanDelinquencyBucket31To60,
+		60:  constant.LoanDelinquencyBucket31To60,
+		61:  constant.LoanDelinquencyBucket61To90,
+		90:  constant.LoanDelinquencyBucket61To90,
+		91:  constant.LoanDelinquencyBucket90Plus,
+		365: constant.LoanDelinquencyBucket90Plus,
+	}
+
+	for daysPastDue, want := range tm {
+		assert.Equal(t, want, delinquencyBucket(daysPastDue), "days past due: %d", daysPastDue)
+	}
+}
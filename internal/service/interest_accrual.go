@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
+)
+
+// accruingLoanStates are the loan states accruing interest daily. Defaulted loans are non-accrual.
+var accruingLoanStates = []constant.LoanState{
+	constant.LoanStateDisbursed,
+	constant.LoanStateRepaying,
+}
+
+// setAccrualEndDate sets the accrual end date of a loan an event moved out of the accruing states on the given date
+// to the day before, so the accrual job can still backfill the days it missed. It is cleared once the loan accrues again.
+func setAccrualEndDate(loan *model.Loan, previous constant.LoanState, date time.Time) {
+	switch {
+	case slices.Contains(accruingLoanStates, loan.State):
+		loan.AccrualEndDate = sql.NullTime{}
+	case slices.Contains(accruingLoanStates, previous):
+		loan.AccrualEndDate = sql.NullTime{Time: truncateDate(date).AddDate(0, 0, -1), Valid: true}
+	}
+}
+
+// dayCountConvention returns app.loan.dayCountConvention, or the default if missing or unknown.
+func (s *service) dayCountConvention() constant.DayCountConvention {
+	switch convention := constant.DayCountConvention(s.config.Loan.DayCountConvention); convention {
+	case constant.DayCountConventionAct365, constant.DayCountConvention30360:
+		return convention
+	}
+
+	return constant.DefaultDayCountConvention
+}
+
+// dayCountFraction returns the fraction of a year a single calendar day accrues under the convention.
+// Under 30/360 every month counts as 30 days: the 31st of a month accrues nothing and the last day of February makes up
+// the month to 30 days.
+func dayCountFraction(convention constant.DayCountConvention, date time.Time) *big.Rat {
+	if convention != constant.DayCountConvention30360 {
+		return big.NewRat(1, 365) //nolint
+	}
+
+	days := int64(1)
+	switch day := date.Day(); {
+	case day == 31: //nolint
+		days = 0
+	case date.Month() == time.February && date.AddDate(0, 0, 1).Day() == 1:
+		days = int64(31 - day) //nolint
+	}
+
+	return big.NewRat(days, 360) //nolint
+}
+
+// outstandingPrincipal returns the principal of a loan not repaid yet.
+func outstandingPrincipal(installments []*model.LoanInstallment) (principal money.Amount) {
+	for _, installment := range installments {
+		principal += installment.PrincipalAmount - installment.PaidPrincipal
+	}
+
+	return
+}
+
+// dailyAccruals builds the accruals of a loan for every date from from through through.
+// Each day accrues on its end-of-day principal, rebuilt from the current outstanding principal and the principal repaid after
+// that day, so backfilled days accrue on the balance they had. Days are rounded to cents individually.
+func dailyAccruals(loan *model.Loan, outstanding money.Amount, repayments []*model.LoanRepayment, convention constant.DayCountConvention, from, through time.Time) (accruals []*model.LoanInterestAccrual) {
+	rate := new(big.Rat).Quo(decimalRat(loan.InterestRate), big.NewRat(100, 1)) //nolint
+	for date := truncateDate(from); !date.After(through); date = date.AddDate(0, 0, 1) {
+		principal := outstanding
+		for _, repayment := range repayments {
+			if truncateDate(repayment.PaymentDate).After(date) {
+				principal += repayment.AppliedPrincipal
+			}
+		}
+
+		accruals = append(accruals, &model.LoanInterestAccrual{
+			LoanId:             loan.Id,
+			AccrualDate:        date,
+			PrincipalAmount:    principal,
+			InterestRate:       loan.InterestRate,
+			DayCountConvention: convention,
+			Amount:             principal.Mul(new(big.Rat).Mul(rate, dayCountFraction(convention, date))),
+			Currency:           loan.Currency,
+			CommonModel: model.CommonModel{
+				CreatedAt: now(),
+			},
+		})
+	}
+
+	return
+}
+
+// AccrueInterest posts the daily interest accruals of every disbursed or repaying loan through yesterday.
+// A loan accrues from the day after its last accrual, or from its disbursement date, so days missed while the job was down are backfilled,
+// including the ones of loans closed or defaulted in the meantime up to their accrual end date.
+// Each loan is processed in its own transaction so one failure does not block the rest of the batch.
+func (s *service) AccrueInterest(ctx context.Context) (res *model.AccrueInterestResponse, err error) {
+	// Get accruing loan IDs.
+	loanIds, err := s.repository.db.GetAccruingLoanIds(ctx, accruingLoanStates)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	res = &model.AccrueInterestResponse{}
+	through := truncateDate(now()).AddDate(0, 0, -1)
+	for _, loanId := range loanIds {
+		posted, errAccrue := s.accrueLoanInterest(ctx, loanId, through)
+		if errAccrue != nil {
+			util.LogContext(ctx).Error(fmt.Sprintf("Failed to accrue interest of loan ID %d: %s", loanId, errAccrue.Error()))
+			continue
+		}
+		if posted == 0 {
+			continue
+		}
+
+		res.AccruedLoanCount++
+		res.PostedAccrualCount += posted
+	}
+
+	return
+}
+
+// accrueLoanInterest posts the missing daily accruals of a single loan through the given date within a serializable transaction.
+func (s *service) accrueLoanInterest(ctx context.Context, loanId uint64, through time.Time) (posted uint32, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, loanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return s.postLoanAccruals(ctx, loan, through, tx)
+}
+
+// postLoanAccruals posts the missing daily accruals of a loan through the given date at its current terms.
+func (s *service) postLoanAccruals(ctx context.Context, loan *model.Loan, through time.Time, tx *sqlx.Tx) (posted uint32, err error) {
+	// Validate loan state, a loan that stopped accruing is backfilled up to its accrual end date.
+	if !slices.Contains(accruingLoanStates, loan.State) {
+		if !loan.AccrualEndDate.Valid {
+			return 0, nil
+		}
+		if end := truncateDate(loan.AccrualEndDate.Time); end.Before(through) {
+			through = end
+		}
+	}
+
+	// Get disbursement.
+	disbursement, err := s.repository.db.GetLoanDisbursementByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if disbursement == nil {
+		return 0, nil
+	}
+
+	// Resume from the day after the last accrual.
+	summary, err := s.repository.db.GetLoanInterestAccrualSummary(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	from := truncateDate(disbursement.DisbursementDate)
+	if summary.LastAccrualDate.Valid {
+		from = truncateDate(summary.LastAccrualDate.Time).AddDate(0, 0, 1)
+	}
+	if from.After(through) {
+		return 0, nil
+	}
+
+	// Get installments.
+	installments, err := s.repository.db.GetInstallmentsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Get repayments.
+	repayments, err := s.repository.db.GetLoanRepaymentsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Post accruals, skipping dates accrued concurrently.
+	accruals := dailyAccruals(loan, outstandingPrincipal(installments), repayments, s.dayCountConvention(), from, through)
+	for _, accrual := range accruals {
+		isPosted, errCreate := s.repository.db.CreateLoanInterestAccrual(ctx, accrual, tx)
+		if errCreate != nil {
+			err = errCreate
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+		if isPosted {
+			posted++
+		}
+	}
+
+	return
+}
+
+// GetAccruedInterest returns the interest a loan accrued to date.
+func (s *service) GetAccruedInterest(ctx context.Context, req *model.GetAccruedInterestRequest) (res *model.GetAccruedInterestResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		ReadOnly: true,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan visibility.
+	if err = s.validateLoanParticipant(ctx, loan, req.UserId, req.RoleId, tx); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get accruals.
+	summary, err := s.repository.db.GetLoanInterestAccrualSummary(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.GetAccruedInterestResponse{
+		LoanId:             loan.Id,
+		Currency:           loan.Currency,
+		State:              string(loan.State),
+		InterestRate:       loan.InterestRate,
+		DayCountConvention: string(s.dayCountConvention()),
+		AccruedAmount:      summary.AccruedAmount,
+		AccruedDays:        summary.AccruedDays,
+		FirstAccrualDate:   summary.FirstAccrualDate.Time,
+		LastAccrualDate:    summary.LastAccrualDate.Time,
+	}
+
+	return
+}
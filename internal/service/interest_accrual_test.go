@@ -0,0 +1,135 @@
+package service
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDayCountFraction(t *testing.T) {
+	principal := 36_000 * money.Unit
+	rate := decimalRat(10)
+	rate.Quo(rate, decimalRat(100))
+
+	tm := []struct {
+		name       string
+		convention constant.DayCountConvention
+		date       time.Time
+		want       money.Amount
+	}{
+		{
+			name:       "act365",
+			convention: constant.DayCountConventionAct365,
+			date:       time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC),
+			want:       986, // 36,000 * 10% / 365
+		},
+		{
+			name:       "30360MidMonth",
+			convention: constant.DayCountConvention30360,
+			date:       time.Date(2025, time.January, 30, 0, 0, 0, 0, time.UTC),
+			want:       10 * money.Unit,
+		},
+		{
+			name:       "30360ThirtyFirst",
+			convention: constant.DayCountConvention30360,
+			date:       time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC),
+			want:       0,
+		},
+		{
+			name:       "30360EndOfFebruary",
+			convention: constant.DayCountConvention30360,
+			date:       time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC),
+			want:       30 * money.Unit,
+		},
+		{
+			name:       "30360EndOfFebruaryLeapYear",
+			convention: constant.DayCountConvention30360,
+			date:       time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+			want:       20 * money.Unit,
+		},
+		{
+			name:       "30360EndOfYear",
+			convention: constant.DayCountConvention30360,
+			date:       time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC),
+			want:       0,
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			fraction := dayCountFraction(tt.convention, tt.date)
+			assert.Equal(t, tt.want, principal.Mul(fraction.Mul(fraction, rate)))
+		})
+	}
+}
+
+func TestDailyAccruals(t *testing.T) {
+	loan := &model.Loan{InterestRate: 10, Currency: "IDR"}
+	loan.Id = 1
+	repayments := []*model.LoanRepayment{
+		{AppliedPrincipal: 3_650 * money.Unit, PaymentDate: time.Date(2025, time.January, 2, 10, 0, 0, 0, time.UTC)},
+	}
+	from := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	through := time.Date(2025, time.January, 3, 0, 0, 0, 0, time.UTC)
+
+	accruals := dailyAccruals(loan, 36_500*money.Unit, repayments, constant.DayCountConventionAct365, from, through)
+
+	// The first day accrues on the principal before the repayment, later days on the balance after it.
+	wantPrincipals := []money.Amount{40_150 * money.Unit, 36_500 * money.Unit, 36_500 * money.Unit}
+	wantAmounts := []money.Amount{11 * money.Unit, 10 * money.Unit, 10 * money.Unit}
+	assert.Len(t, accruals, len(wantAmounts))
+	for i, accrual := range accruals {
+		assert.Equal(t, from.AddDate(0, 0, i), accrual.AccrualDate)
+		assert.Equal(t, wantPrincipals[i], accrual.PrincipalAmount)
+		assert.Equal(t, wantAmounts[i], accrual.Amount)
+		assert.Equal(t, constant.DayCountConventionAct365, accrual.DayCountConvention)
+	}
+
+	assert.Empty(t, dailyAccruals(loan, 36_500*money.Unit, nil, constant.DayCountConventionAct365, through.AddDate(0, 0, 1), through))
+}
+
+func TestDayCountConvention(t *testing.T) {
+	for configured, want := range map[string]constant.DayCountConvention{
+		"":        constant.DefaultDayCountConvention,
+		"ACT/360": constant.DefaultDayCountConvention,
+		"ACT/365": constant.DayCountConventionAct365,
+		"30/360":  constant.DayCountConvention30360,
+	} {
+		s := &service{config: &model.AppConfig{Loan: model.LoanConfig{DayCountConvention: configured}}}
+		assert.Equal(t, want, s.dayCountConvention())
+	}
+}
+
+func TestSetAccrualEndDate(t *testing.T) {
+	date := time.Date(2025, time.March, 10, 15, 0, 0, 0, time.UTC)
+	ended := sql.NullTime{Time: time.Date(2025, time.March, 9, 0, 0, 0, 0, time.UTC), Valid: true}
+
+	tm := []struct {
+		name     string
+		previous constant.LoanState
+		state    constant.LoanState
+		endDate  sql.NullTime
+		want     sql.NullTime
+	}{
+		{name: "closed", previous: constant.LoanStateRepaying, state: constant.LoanStateClosed, want: ended},
+		{name: "defaulted", previous: constant.LoanStateDisbursed, state: constant.LoanStateDefaulted, want: ended},
+		{name: "stillAccruing", previous: constant.LoanStateDisbursed, state: constant.LoanStateRepaying},
+		{name: "closedAfterDefault", previous: constant.LoanStateDefaulted, state: constant.LoanStateClosed, endDate: sql.NullTime{Time: date.AddDate(0, -1, 0), Valid: true}, want: sql.NullTime{Time: date.AddDate(0, -1, 0), Valid: true}},
+		{name: "accruingAgain", previous: constant.LoanStateDefaulted, state: constant.LoanStateRepaying, endDate: ended},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			loan := &model.Loan{State: tt.state, AccrualEndDate: tt.endDate}
+			setAccrualEndDate(loan, tt.previous, date)
+			assert.Equal(t, tt.want, loan.AccrualEndDate)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/statemachine"
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// coolingOffPeriod returns the configured cooling-off period, or the default one if it is missing or malformed.
+func (s *service) coolingOffPeriod() time.Duration {
+	d, err := time.ParseDuration(s.config.Loan.CoolingOffPeriod)
+	if err != nil || d <= 0 {
+		return constant.DefaultCoolingOffPeriod
+	}
+
+	return d
+}
+
+// CancelInvestment withdraws an investment within its cooling-off period, as long as the loan is not fully invested yet.
+// The investment is kept as CANCELLED and the loan goes back to approved once no investment is left in it.
+func (s *service) CancelInvestment(ctx context.Context, req *model.CancelInvestmentRequest) (res *model.CancelInvestmentResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get investment by ID.
+	investment, err := s.repository.db.GetLoanInvestmentById(ctx, req.InvestmentId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Investments of other investors are reported as not found.
+	if investment.InvestorId != req.InvestorId {
+		err = constant.ErrInvestmentNotFound
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate investment status and cooling-off period.
+	if investment.Status != constant.LoanInvestmentStatusActive {
+		err = constant.ErrInvestmentNotActive
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if now().After(investment.InvestedAt.Add(s.coolingOffPeriod())) {
+		err = constant.ErrCoolingOffPeriodOver
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, investment.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Move the loan back to approved once no investment is left, keep it funding otherwise.
+	loan.InvestedAmount -= investment.Amount
+	event := statemachine.EventWithdraw
+	if loan.InvestedAmount == 0 {
+		event = statemachine.EventFullyWithdraw
+	}
+	if err = statemachine.Loan.Fire(loan, event, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Mark investment as cancelled.
+	investment.Status = constant.LoanInvestmentStatusCancelled
+	investment.ReversedAt = sql.NullTime{Time: now(), Valid: true}
+	err = s.repository.db.UpdateLoanInvestmentStatus(ctx, investment, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Update loan with new invested amount and state.
+	loan.UpdatedBy = sql.NullInt64{Int64: int64(req.InvestorId), Valid: true}
+	err = s.repository.db.UpdateLoan(ctx, loan, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.CancelInvestmentResponse{
+		InvestmentId:   investment.Id,
+		LoanId:         loan.Id,
+		Amount:         investment.Amount,
+		Currency:       investment.Currency,
+		State:          string(loan.State),
+		InvestedAmount: loan.InvestedAmount,
+		CancelledAt:    investment.ReversedAt.Time,
+	}
+
+	return
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoolingOffPeriod(t *testing.T) {
+	tm := []struct {
+		name   string
+		config string
+		want   time.Duration
+	}{
+		{name: "configured", config: "48h", want: 48 * time.Hour},
+		{name: "missing", config: "", want: constant.DefaultCoolingOffPeriod},
+		{name: "malformed", config: "two days", want: constant.DefaultCoolingOffPeriod},
+		{name: "negative", config: "-1h", want: constant.DefaultCoolingOffPeriod},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			s := &service{config: &model.AppConfig{Loan: model.LoanConfig{CoolingOffPeriod: tt.config}}}
+			assert.Equal(t, tt.want, s.coolingOffPeriod())
+		})
+	}
+}
@@ -3,17 +3,56 @@ package service
 import (
 	"context"
 	"database/sql"
+	"slices"
 
 	"github.com/ffauzann/loan-service/internal/constant"
 	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/statemachine"
 	"github.com/ffauzann/loan-service/internal/util"
+	"github.com/jmoiron/sqlx"
 )
 
 func (s *service) CreateLoan(ctx context.Context, req *model.CreateLoanRequest) (res *model.CreateLoanResponse, err error) {
+	// Use default tenor if not requested.
+	if req.TenorMonths == 0 {
+		req.TenorMonths = constant.DefaultLoanTenorMonths
+	}
+
+	// Validate currency and principal amount.
+	currency, err := s.currency(req.Currency)
+	if err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if !currency.validPrincipal(req.PrincipalAmount) {
+		err = constant.ErrPrincipalAmountOutOfRange
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Validate borrower credit limit.
+	if err = s.checkCreditLimit(ctx, req.BorrowerId, currency, req.PrincipalAmount, tx); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
 	// Prepare loan model
 	loan := &model.Loan{
 		BorrowerId:      req.BorrowerId,
 		PrincipalAmount: req.PrincipalAmount,
+		Currency:        currency.code,
+		TenorMonths:     req.TenorMonths,
 		State:           constant.LoanStateProposed,
 		CommonModel: model.CommonModel{
 			CreatedAt: now(),
@@ -24,43 +63,116 @@ func (s *service) CreateLoan(ctx context.Context, req *model.CreateLoanRequest)
 	}
 
 	// Create loan.
-	err = s.repository.db.CreateLoan(ctx, loan, nil)
+	err = s.repository.db.CreateLoan(ctx, loan, tx)
 	if err != nil {
 		util.LogContext(ctx).Error(err.Error())
 		return
 	}
 
+	// Assign loan to a field validator, it stays in the queue if none is available.
+	if _, err = s.assignNextValidator(ctx, loan, nil, loan.CreatedBy, tx); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
 	// Construct response.
 	res = &model.CreateLoanResponse{
-		LoanId:    loan.Id,
-		State:     string(constant.LoanStateProposed),
-		CreatedAt: loan.CreatedAt,
+		LoanId:      loan.Id,
+		Currency:    loan.Currency,
+		State:       string(constant.LoanStateProposed),
+		CreatedAt:   loan.CreatedAt,
+		TenorMonths: loan.TenorMonths,
 	}
 
 	return
 }
 
+// canApproveStep reports whether a user with roleId may act for an approval step requiring stepRoleId.
+// Staff role IDs are ordered from the most senior, who may act for the steps of more junior roles.
+func canApproveStep(roleId, stepRoleId uint8) bool {
+	return slices.Contains(constant.AllowedRolesApproveLoan, roleId) && roleId <= stepRoleId
+}
+
 func (s *service) ApproveLoan(ctx context.Context, req *model.ApproveLoanRequest) (res *model.ApproveLoanResponse, err error) {
+	// Run the auto invest rules once the last approval step is committed.
+	defer func() {
+		if err == nil && res.State == string(constant.LoanStateApproved) {
+			go s.autoInvest(context.Background(), res.LoanId)
+		}
+	}()
+
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
 	// Get loan by ID.
-	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, nil)
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
 	if err != nil {
 		util.LogContext(ctx).Error(err.Error())
 		return
 	}
 
 	// Validate loan state.
-	if loan.State != constant.LoanStateProposed {
-		err = constant.ErrLoanNotProposed
+	if err = statemachine.Loan.Can(loan, statemachine.EventApprove, req.RoleId); err != nil {
 		util.LogContext(ctx).Warn(err.Error())
 		return
 	}
 
-	// Prepare loan model
+	// Get the approval steps required by the principal and the ones already done.
+	currency, err := s.currency(loan.Currency)
+	if err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	steps := currency.approvalSteps(loan.PrincipalAmount)
+	approvals, err := s.repository.db.GetLoanApprovalsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate field validators are the current assignee of the loan.
+	assignment, err := s.repository.db.GetOpenLoanAssignment(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if req.RoleId == constant.RoleIdFieldValidator && (assignment == nil || assignment.ValidatorId != req.ValidatorId) {
+		err = constant.ErrLoanNotAssigned
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate approver, tiers lowered after the first steps complete the approval with this step.
+	step := uint32(len(approvals)) + 1
+	requiredSteps := max(uint32(len(steps)), step)
+	if slices.ContainsFunc(approvals, func(approval *model.LoanApproval) bool {
+		return approval.ValidatorId == req.ValidatorId
+	}) {
+		err = constant.ErrDuplicateApprover
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if !canApproveStep(req.RoleId, steps[min(int(step), len(steps))-1]) {
+		err = constant.ErrApprovalStepRole
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Prepare loan approval model.
 	loanApproval := &model.LoanApproval{
-		ValidatorId:    req.ValidatorId,
-		LoanId:         req.LoanId,
-		PhotoProofLink: req.PhotoProofLink,
-		ApprovalDate:   now(),
+		ValidatorId:   req.ValidatorId,
+		RoleId:        req.RoleId,
+		LoanId:        req.LoanId,
+		Step:          step,
+		RequiredSteps: requiredSteps,
+		ApprovalDate:  now(),
 		CommonModel: model.CommonModel{
 			CreatedAt: now(),
 			CreatedBy: sql.NullInt64{Int64: int64(req.ValidatorId), Valid: true},
@@ -69,10 +181,82 @@ func (s *service) ApproveLoan(ctx context.Context, req *model.ApproveLoanRequest
 		},
 	}
 
-	// Set loan state to approved.
-	loan.State = constant.LoanStateApproved
+	// Set the loan terms on the first step, later steps sign them off.
+	if step == 1 {
+		if req.PhotoProofLink == "" || req.InterestRate == 0 || req.ROI == 0 {
+			err = constant.ErrApprovalTermsRequired
+			util.LogContext(ctx).Warn(err.Error())
+			return
+		}
+		loanApproval.PhotoProofLink = &req.PhotoProofLink
+		setApprovalTerms(loan, req)
+	}
 	loan.UpdatedBy = sql.NullInt64{Int64: int64(req.ValidatorId), Valid: true}
 
+	// Set loan state to approved and open it for funding until the deadline once every step is done.
+	if step == requiredSteps {
+		// Validate borrower credit limit, the loan itself is already part of the exposure.
+		if err = s.checkCreditLimit(ctx, loan.BorrowerId, currency, 0, tx); err != nil {
+			util.LogContext(ctx).Warn(err.Error())
+			return
+		}
+
+		if err = statemachine.Loan.Fire(loan, statemachine.EventApprove, req.RoleId); err != nil {
+			util.LogContext(ctx).Warn(err.Error())
+			return
+		}
+		loan.FundingDeadline = sql.NullTime{Time: loanApproval.ApprovalDate.Add(s.fundingPeriod()), Valid: true}
+	}
+
+	// Update loan.
+	err = s.repository.db.UpdateLoan(ctx, loan, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Create loan approval.
+	err = s.repository.db.ApproveLoan(ctx, loanApproval, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Complete the assignment and hand the loan over to another validator if the next step needs one.
+	if err = s.closeLoanAssignment(ctx, assignment, constant.LoanAssignmentStatusCompleted, req.ValidatorId, tx); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if step < requiredSteps {
+		if _, err = s.assignNextValidator(ctx, loan, append(approvals, loanApproval), loanApproval.CreatedBy, tx); err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+	}
+
+	// Construct response.
+	res = &model.ApproveLoanResponse{
+		LoanId:          loanApproval.LoanId,
+		State:           string(loan.State),
+		ApprovalDate:    loanApproval.ApprovalDate,
+		FundingDeadline: loan.FundingDeadline.Time,
+		RiskGrade:       string(loan.RiskGrade),
+		ApprovalStep:    loanApproval.Step,
+		RequiredSteps:   loanApproval.RequiredSteps,
+	}
+
+	return
+}
+
+// setApprovalTerms sets the terms agreed on approval, which the repayment schedule and investor returns are built from.
+func setApprovalTerms(loan *model.Loan, req *model.ApproveLoanRequest) {
+	loan.InterestRate = req.InterestRate
+	loan.ROI = req.ROI
+	loan.RiskGrade = constant.LoanRiskGrade(req.RiskGrade)
+}
+
+// RejectLoan handles the rejection of a loan proposal by a field validator.
+func (s *service) RejectLoan(ctx context.Context, req *model.RejectLoanRequest) (res *model.RejectLoanResponse, err error) {
 	// Begin tx.
 	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
 		Isolation: sql.LevelSerializable,
@@ -83,25 +267,69 @@ func (s *service) ApproveLoan(ctx context.Context, req *model.ApproveLoanRequest
 	}
 	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
 
-	// Update loan state to approved.
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan state.
+	if err = statemachine.Loan.Can(loan, statemachine.EventReject, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Prepare rejection model.
+	loanRejection := &model.LoanRejection{
+		LoanId:        req.LoanId,
+		ValidatorId:   req.ValidatorId,
+		ReasonCode:    constant.LoanRejectionReason(req.ReasonCode),
+		Note:          req.Note,
+		RejectionDate: now(),
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.ValidatorId), Valid: true},
+			UpdatedAt: sql.NullTime{Time: now(), Valid: true},
+			UpdatedBy: sql.NullInt64{Int64: int64(req.ValidatorId), Valid: true},
+		},
+	}
+
+	// Update loan state to rejected.
+	if err = statemachine.Loan.Fire(loan, statemachine.EventReject, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	loan.UpdatedBy = sql.NullInt64{Int64: int64(req.ValidatorId), Valid: true}
 	err = s.repository.db.UpdateLoan(ctx, loan, tx)
 	if err != nil {
 		util.LogContext(ctx).Error(err.Error())
 		return
 	}
 
-	// Create loan approval.
-	err = s.repository.db.ApproveLoan(ctx, loanApproval, tx)
+	// Create loan rejection.
+	err = s.repository.db.CreateLoanRejection(ctx, loanRejection, tx)
 	if err != nil {
 		util.LogContext(ctx).Error(err.Error())
 		return
 	}
 
+	// Complete the assignment.
+	assignment, err := s.repository.db.GetOpenLoanAssignment(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if err = s.closeLoanAssignment(ctx, assignment, constant.LoanAssignmentStatusCompleted, req.ValidatorId, tx); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
 	// Construct response.
-	res = &model.ApproveLoanResponse{
-		LoanId:       loanApproval.LoanId,
-		State:        string(constant.LoanStateApproved),
-		ApprovalDate: loanApproval.ApprovalDate,
+	res = &model.RejectLoanResponse{
+		LoanId:        loanRejection.LoanId,
+		State:         string(loan.State),
+		RejectionDate: loanRejection.RejectionDate,
 	}
 
 	return
@@ -126,40 +354,106 @@ func (s *service) InvestInLoan(ctx context.Context, req *model.InvestInLoanReque
 		return
 	}
 
+	// Validate investment.
+	if _, err = s.validateInvestment(ctx, loan, req, 0, tx); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Save investment and update loan.
+	if _, err = s.placeInvestment(ctx, loan, req, tx); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.InvestInLoanResponse{
+		LoanId:         req.LoanId,
+		InvestedAmount: req.Amount,
+		Currency:       loan.Currency,
+		State:          string(loan.State),
+	}
+
+	return
+}
+
+// validateInvestment runs the checks every investment in a loan goes through, whether placed directly or through a reservation.
+// held is the amount of the reservation being confirmed, zero otherwise. It returns the capacity of the loan available to the investment.
+// Unexpired reservations hold their amount, so it is neither available to others nor counted twice for the investor confirming one.
+func (s *service) validateInvestment(ctx context.Context, loan *model.Loan, req *model.InvestInLoanRequest, held money.Amount, tx *sqlx.Tx) (available money.Amount, err error) {
 	// Validate loan state.
-	if loan.State != constant.LoanStateApproved && loan.State != constant.LoanStateFunding {
-		err = constant.ErrLoanNotApproved
+	if err = statemachine.Loan.Can(loan, statemachine.EventInvest, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate investment currency against the loan currency.
+	currency, err := s.currency(req.Currency)
+	if err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if currency.code != loan.Currency {
+		err = constant.ErrCurrencyMismatch
 		util.LogContext(ctx).Warn(err.Error())
 		return
 	}
 
 	// Validate investment amount.
-	if req.Amount < constant.MinInvestmentAmount || req.Amount > constant.MaxInvestmentAmount {
+	if !currency.validInvestment(req.Amount) {
 		err = constant.ErrInvestmentAmountOutOfRange
 		util.LogContext(ctx).Warn(err.Error())
 		return
 	}
 
+	// Get amounts held by reservations.
+	reserved, err := s.repository.db.GetLoanReservedAmount(ctx, loan.Id, 0, now(), tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	reservedByInvestor, err := s.repository.db.GetLoanReservedAmount(ctx, loan.Id, req.InvestorId, now(), tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate investor concentration, counting its other reservations.
+	if err = s.checkConcentration(ctx, loan, req.InvestorId, req.Amount+reservedByInvestor-held, currency, tx); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
 	// Validate funding progress.
-	finalInvestedAmount := loan.InvestedAmount + req.Amount
-	if finalInvestedAmount > loan.PrincipalAmount {
+	available = loanCapacity(loan, reserved, held)
+	if req.Amount > available {
 		err = constant.ErrInvestmentAmountOutOfRange
 		util.LogContext(ctx).Warn(err.Error())
 		return
 	}
 
-	// Validate if the loan is fully funded.
-	if loan.InvestedAmount+req.Amount == loan.PrincipalAmount {
-		loan.State = constant.LoanStateInvested
-	} else {
-		loan.State = constant.LoanStateFunding
+	return
+}
+
+// placeInvestment saves an investment validated by validateInvestment and moves the loan to invested once fully funded, to funding otherwise.
+func (s *service) placeInvestment(ctx context.Context, loan *model.Loan, req *model.InvestInLoanRequest, tx *sqlx.Tx) (investment *model.LoanInvestment, err error) {
+	// Move the loan to invested once fully funded, to funding otherwise.
+	loan.InvestedAmount += req.Amount
+	event := statemachine.EventInvest
+	if loan.InvestedAmount == loan.PrincipalAmount {
+		event = statemachine.EventFullyInvest
+	}
+	if err = statemachine.Loan.Fire(loan, event, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
 	}
 
 	// Prepare investment model.
-	investment := &model.LoanInvestment{
-		LoanId:     req.LoanId,
+	investment = &model.LoanInvestment{
+		LoanId:     loan.Id,
 		InvestorId: req.InvestorId,
 		Amount:     req.Amount,
+		Currency:   loan.Currency,
 		CommonModel: model.CommonModel{
 			CreatedAt: now(),
 			CreatedBy: sql.NullInt64{Int64: int64(req.InvestorId), Valid: true},
@@ -175,8 +469,15 @@ func (s *service) InvestInLoan(ctx context.Context, req *model.InvestInLoanReque
 		return
 	}
 
+	// Generate agreements once fully funded.
+	if loan.State == constant.LoanStateInvested {
+		if err = s.generateAgreements(ctx, loan, req.InvestorId, tx); err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+	}
+
 	// Update loan with new invested amount and state.
-	loan.InvestedAmount = finalInvestedAmount
 	loan.UpdatedBy = sql.NullInt64{Int64: int64(req.InvestorId), Valid: true}
 	err = s.repository.db.UpdateLoan(ctx, loan, tx)
 	if err != nil {
@@ -189,13 +490,6 @@ func (s *service) InvestInLoan(ctx context.Context, req *model.InvestInLoanReque
 		go s.notifyLoanFullyFunded(context.Background(), loan.Id)
 	}
 
-	// Construct response.
-	res = &model.InvestInLoanResponse{
-		LoanId:         req.LoanId,
-		InvestedAmount: req.Amount,
-		State:          string(loan.State),
-	}
-
 	return
 }
 
@@ -219,8 +513,13 @@ func (s *service) DisburseLoan(ctx context.Context, req *model.DisburseLoanReque
 	}
 
 	// Validate loan state.
-	if loan.State != constant.LoanStateInvested {
-		err = constant.ErrLoanNotFullyInvested
+	if err = statemachine.Loan.Can(loan, statemachine.EventDisburse, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate signed agreement.
+	if err = validateSignedAgreement(loan, req.SignedAgreementLink); err != nil {
 		util.LogContext(ctx).Warn(err.Error())
 		return
 	}
@@ -246,8 +545,11 @@ func (s *service) DisburseLoan(ctx context.Context, req *model.DisburseLoanReque
 		return
 	}
 
-	// Update loan with new invested amount and state.
-	loan.State = constant.LoanStateDisbursed
+	// Update loan state to disbursed.
+	if err = statemachine.Loan.Fire(loan, statemachine.EventDisburse, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
 	loan.UpdatedBy = sql.NullInt64{Int64: int64(req.OfficerId), Valid: true}
 	err = s.repository.db.UpdateLoan(ctx, loan, tx)
 	if err != nil {
@@ -255,6 +557,14 @@ func (s *service) DisburseLoan(ctx context.Context, req *model.DisburseLoanReque
 		return
 	}
 
+	// Generate repayment schedule.
+	installments := buildRepaymentSchedule(loan, disbursement.DisbursementDate, req.OfficerId)
+	err = s.repository.db.CreateLoanInstallments(ctx, installments, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
 	// Construct response.
 	res = &model.DisburseLoanResponse{
 		LoanId:           req.LoanId,
@@ -264,3 +574,185 @@ func (s *service) DisburseLoan(ctx context.Context, req *model.DisburseLoanReque
 
 	return
 }
+
+// CancelLoan handles the cancellation of a loan by its borrower before funding completes.
+func (s *service) CancelLoan(ctx context.Context, req *model.CancelLoanRequest) (res *model.CancelLoanResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan ownership.
+	if loan.BorrowerId != req.BorrowerId {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate loan state.
+	if err = statemachine.Loan.Can(loan, statemachine.EventCancel, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Reverse partial investments if any.
+	investments, err := s.repository.db.ReverseLoanInvestments(ctx, loan.Id, constant.LoanInvestmentStatusReversed, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	var reversedAmount money.Amount
+	for _, investment := range investments {
+		reversedAmount += investment.Amount
+	}
+
+	// Prepare cancellation model.
+	cancellation := &model.LoanCancellation{
+		LoanId:           loan.Id,
+		BorrowerId:       req.BorrowerId,
+		Reason:           req.Reason,
+		ReversedAmount:   reversedAmount,
+		CancellationDate: now(),
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.BorrowerId), Valid: true},
+			UpdatedAt: sql.NullTime{Time: now(), Valid: true},
+			UpdatedBy: sql.NullInt64{Int64: int64(req.BorrowerId), Valid: true},
+		},
+	}
+
+	// Save cancellation record.
+	err = s.repository.db.CreateLoanCancellation(ctx, cancellation, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Update loan state to cancelled and release invested amount.
+	if err = statemachine.Loan.Fire(loan, statemachine.EventCancel, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	loan.InvestedAmount = 0
+	loan.UpdatedBy = sql.NullInt64{Int64: int64(req.BorrowerId), Valid: true}
+	err = s.repository.db.UpdateLoan(ctx, loan, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	if len(investments) > 0 {
+		// Notify affected investors about the reversal.
+		go s.notifyLoanCancelled(context.Background(), loan.Id, investments)
+	}
+
+	// Construct response.
+	res = &model.CancelLoanResponse{
+		LoanId:                  loan.Id,
+		Currency:                loan.Currency,
+		State:                   string(loan.State),
+		ReversedAmount:          reversedAmount,
+		ReversedInvestmentCount: uint32(len(investments)),
+		CancellationDate:        cancellation.CancellationDate,
+	}
+
+	return
+}
+
+// GetRepaymentSchedule returns the installments of a disbursed loan.
+// Borrowers may only see their own loans and investors only the loans they have invested in.
+func (s *service) GetRepaymentSchedule(ctx context.Context, req *model.GetRepaymentScheduleRequest) (res *model.GetRepaymentScheduleResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		ReadOnly: true,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan visibility.
+	if err = s.validateLoanParticipant(ctx, loan, req.UserId, req.RoleId, tx); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get installments.
+	installments, err := s.repository.db.GetInstallmentsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.GetRepaymentScheduleResponse{
+		LoanId:       loan.Id,
+		Currency:     loan.Currency,
+		State:        string(loan.State),
+		TenorMonths:  loan.TenorMonths,
+		InterestRate: loan.InterestRate,
+		Installments: make([]*model.Installment, 0, len(installments)),
+	}
+	for _, installment := range installments {
+		res.TotalPrincipal += installment.PrincipalAmount
+		res.TotalInterest += installment.InterestAmount
+		res.Installments = append(res.Installments, &model.Installment{
+			InstallmentNumber: installment.InstallmentNumber,
+			DueDate:           installment.DueDate,
+			PrincipalAmount:   installment.PrincipalAmount,
+			InterestAmount:    installment.InterestAmount,
+			FeeAmount:         installment.FeeAmount,
+			TotalAmount:       installment.PrincipalAmount + installment.InterestAmount + installment.FeeAmount,
+			PaidAmount:        installment.PaidFee + installment.PaidInterest + installment.PaidPrincipal,
+			Status:            string(installment.Status),
+		})
+	}
+
+	return
+}
+
+// validateLoanParticipant makes sure borrowers only access their own loans and investors only the loans they have invested in.
+// Other allowed roles (admins) can access every loan.
+func (s *service) validateLoanParticipant(ctx context.Context, loan *model.Loan, userId uint64, roleId uint8, tx *sqlx.Tx) (err error) {
+	switch roleId {
+	case constant.RoleIdBorrower:
+		if loan.BorrowerId != userId {
+			return constant.ErrPermissionDenied
+		}
+	case constant.RoleIdInvestor:
+		investments, err := s.repository.db.GetInvestmentsByLoanId(ctx, loan.Id, tx)
+		if err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return err
+		}
+
+		if !slices.ContainsFunc(investments, func(investment *model.LoanInvestment) bool {
+			return investment.InvestorId == userId
+		}) {
+			return constant.ErrPermissionDenied
+		}
+	}
+
+	return
+}
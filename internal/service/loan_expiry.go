@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/statemachine"
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// ExpireLoans moves every approved/funding loan past its funding deadline to EXPIRED and refunds its investments.
+// Each loan is expired in its own transaction so one failure does not block the rest of the batch.
+func (s *service) ExpireLoans(ctx context.Context) (res *model.ExpireLoansResponse, err error) {
+	// Get overdue loan IDs.
+	loanIds, err := s.repository.db.GetOverdueLoanIds(ctx, now())
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	res = &model.ExpireLoansResponse{
+		ExpiredLoanIds:  []uint64{},
+		RefundedAmounts: map[string]money.Amount{},
+	}
+
+	for _, loanId := range loanIds {
+		investments, expired, errExpire := s.expireLoan(ctx, loanId)
+		if errExpire != nil {
+			util.LogContext(ctx).Error(fmt.Sprintf("Failed to expire loan ID %d: %s", loanId, errExpire.Error()))
+			continue
+		}
+		if !expired {
+			continue // Loan has changed since it was listed.
+		}
+
+		res.ExpiredLoanIds = append(res.ExpiredLoanIds, loanId)
+		for _, investment := range investments {
+			res.RefundedAmounts[investment.Currency] += investment.Amount
+		}
+
+		if len(investments) > 0 {
+			// Notify affected investors about the refund.
+			go s.notifyLoanExpired(context.Background(), loanId, investments)
+		}
+	}
+
+	return
+}
+
+// expireLoan expires a single loan and refunds its investments within a serializable transaction.
+func (s *service) expireLoan(ctx context.Context, loanId uint64) (investments []*model.LoanInvestment, expired bool, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, loanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Re-validate loan state and deadline within the tx.
+	if statemachine.Loan.Can(loan, statemachine.EventExpire, constant.RoleIdSystem) != nil {
+		return
+	}
+	if !loan.FundingDeadline.Valid || loan.FundingDeadline.Time.After(now()) {
+		return
+	}
+
+	// Refund investments if any.
+	investments, err = s.repository.db.ReverseLoanInvestments(ctx, loan.Id, constant.LoanInvestmentStatusRefunded, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Update loan state to expired and release invested amount.
+	if err = statemachine.Loan.Fire(loan, statemachine.EventExpire, constant.RoleIdSystem); err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	loan.InvestedAmount = 0
+	loan.UpdatedBy = sql.NullInt64{} // Expired by the system.
+	err = s.repository.db.UpdateLoan(ctx, loan, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	return investments, true, nil
+}
+
+// fundingPeriod returns the configured funding period, or the default one if it is missing or malformed.
+func (s *service) fundingPeriod() time.Duration {
+	d, err := time.ParseDuration(s.config.Loan.FundingPeriod)
+	if err != nil || d <= 0 {
+		return constant.DefaultFundingPeriod
+	}
+
+	return d
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/statemachine"
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// marketplaceLoanStates are the states in which a loan is listed to every investor.
+var marketplaceLoanStates = []constant.LoanState{
+	constant.LoanStateApproved,
+	constant.LoanStateFunding,
+}
+
+// loanCursor is the position of the last loan of a page. It carries the sort it was issued for,
+// so it cannot be replayed against a listing ordered differently.
+type loanCursor struct {
+	SortBy     constant.LoanSortField `json:"s"`
+	Descending bool                   `json:"d"`
+	Value      string                 `json:"v"`
+	Id         uint64                 `json:"i"`
+}
+
+// encodeLoanCursor returns the opaque cursor pointing right after the given loan.
+func encodeLoanCursor(loan *model.Loan, sortBy constant.LoanSortField, descending bool) string {
+	cursor := loanCursor{SortBy: sortBy, Descending: descending, Id: loan.Id}
+	switch sortBy {
+	case constant.LoanSortFieldPrincipalAmount:
+		cursor.Value = loan.PrincipalAmount.String()
+	case constant.LoanSortFieldInterestRate:
+		cursor.Value = strconv.FormatFloat(loan.InterestRate, 'f', -1, 64)
+	default:
+		cursor.Value = loan.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	b, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeLoanCursor parses a cursor issued by encodeLoanCursor for the same sort.
+func decodeLoanCursor(s string, sortBy constant.LoanSortField, descending bool) (cursor loanCursor, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor, constant.ErrInvalidCursor
+	}
+	if err = json.Unmarshal(b, &cursor); err != nil {
+		return cursor, constant.ErrInvalidCursor
+	}
+	if cursor.SortBy != sortBy || cursor.Descending != descending || cursor.Id == 0 {
+		return cursor, constant.ErrInvalidCursor
+	}
+
+	// Make sure the value casts to the sort column type before it reaches the query.
+	switch sortBy {
+	case constant.LoanSortFieldPrincipalAmount, constant.LoanSortFieldInterestRate:
+		_, err = strconv.ParseFloat(cursor.Value, 64)
+	default:
+		_, err = time.Parse(time.RFC3339Nano, cursor.Value)
+	}
+	if err != nil {
+		return cursor, constant.ErrInvalidCursor
+	}
+
+	return
+}
+
+// loanFilter builds the repository filter of a listing request, scoped to what the requester may see.
+func loanFilter(req *model.ListLoansRequest) (filter *model.LoanFilter, err error) {
+	filter = &model.LoanFilter{
+		BorrowerId:   req.BorrowerId,
+		MinPrincipal: req.MinPrincipal,
+		MaxPrincipal: req.MaxPrincipal,
+		SortBy:       constant.LoanSortFieldCreatedAt,
+		Descending:   req.SortOrder != "asc",
+		Limit:        constant.DefaultLoanPageSize,
+	}
+	if req.SortBy != "" {
+		filter.SortBy = constant.LoanSortField(req.SortBy)
+	}
+	if req.Limit > 0 {
+		filter.Limit = min(req.Limit, constant.MaxLoanPageSize)
+	}
+
+	// Validate states.
+	states := statemachine.Loan.States()
+	for _, state := range req.States {
+		if !slices.Contains(states, constant.LoanState(state)) {
+			return nil, constant.ErrInvalidLoanFilter
+		}
+		filter.States = append(filter.States, constant.LoanState(state))
+	}
+
+	// Validate ranges.
+	if filter.MaxPrincipal > 0 && filter.MinPrincipal > filter.MaxPrincipal {
+		return nil, constant.ErrInvalidLoanFilter
+	}
+	if req.CreatedFrom != "" {
+		if filter.CreatedFrom, err = time.Parse(time.DateOnly, req.CreatedFrom); err != nil {
+			return nil, constant.ErrInvalidLoanFilter
+		}
+	}
+	if req.CreatedTo != "" {
+		if filter.CreatedTo, err = time.Parse(time.DateOnly, req.CreatedTo); err != nil {
+			return nil, constant.ErrInvalidLoanFilter
+		}
+		filter.CreatedTo = filter.CreatedTo.AddDate(0, 0, 1) // The filter end is exclusive.
+	}
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && !filter.CreatedFrom.Before(filter.CreatedTo) {
+		return nil, constant.ErrInvalidLoanFilter
+	}
+
+	// Scope to the requester.
+	switch req.RoleId {
+	case constant.RoleIdBorrower:
+		filter.BorrowerId = req.UserId
+	case constant.RoleIdInvestor:
+		filter.InvestorId = req.UserId
+		filter.MarketStates = marketplaceLoanStates
+	}
+
+	// Resume after the cursor.
+	if req.Cursor != "" {
+		cursor, err := decodeLoanCursor(req.Cursor, filter.SortBy, filter.Descending)
+		if err != nil {
+			return nil, err
+		}
+		filter.AfterValue, filter.AfterId = cursor.Value, cursor.Id
+	}
+
+	return
+}
+
+func (s *service) GetLoan(ctx context.Context, req *model.GetLoanRequest) (res *model.GetLoanResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		ReadOnly: true,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan visibility, marketplace loans are visible to every investor.
+	if req.RoleId != constant.RoleIdInvestor || !slices.Contains(marketplaceLoanStates, loan.State) {
+		if err = s.validateLoanParticipant(ctx, loan, req.UserId, req.RoleId, tx); err != nil {
+			util.LogContext(ctx).Warn(err.Error())
+			return
+		}
+	}
+
+	// Construct response.
+	res = &model.GetLoanResponse{
+		Loan: loan,
+	}
+
+	return
+}
+
+func (s *service) ListLoans(ctx context.Context, req *model.ListLoansRequest) (res *model.ListLoansResponse, err error) {
+	// Build filter.
+	filter, err := loanFilter(req)
+	if err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get one loan past the page to know whether there is a next page.
+	limit := filter.Limit
+	filter.Limit++
+	loans, err := s.repository.db.GetLoans(ctx, filter)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.ListLoansResponse{
+		Loans: loans,
+	}
+	if uint32(len(loans)) > limit {
+		res.Loans = loans[:limit]
+		res.NextCursor = encodeLoanCursor(res.Loans[limit-1], filter.SortBy, filter.Descending)
+	}
+
+	return
+}
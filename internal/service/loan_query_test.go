@@ -0,0 +1,149 @@
+package service
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoanCursor(t *testing.T) {
+	loan := &model.Loan{
+		CommonModel:     model.CommonModel{Id: 42, CreatedAt: time.Date(2024, 3, 1, 10, 30, 0, 123, time.UTC)},
+		PrincipalAmount: 1_500 * money.Unit,
+		InterestRate:    12.5,
+	}
+
+	tm := []struct {
+		name       string
+		sortBy     constant.LoanSortField
+		descending bool
+		value      string
+	}{
+		{
+			name:       "createdAtDesc",
+			sortBy:     constant.LoanSortFieldCreatedAt,
+			descending: true,
+			value:      "2024-03-01T10:30:00.000000123Z",
+		},
+		{
+			name:   "principalAmountAsc",
+			sortBy: constant.LoanSortFieldPrincipalAmount,
+			value:  "1500.00",
+		},
+		{
+			name:   "interestRateAsc",
+			sortBy: constant.LoanSortFieldInterestRate,
+			value:  "12.5",
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := encodeLoanCursor(loan, tt.sortBy, tt.descending)
+
+			cursor, err := decodeLoanCursor(encoded, tt.sortBy, tt.descending)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.value, cursor.Value)
+			assert.Equal(t, loan.Id, cursor.Id)
+
+			// Replaying the cursor against another order is rejected.
+			_, err = decodeLoanCursor(encoded, tt.sortBy, !tt.descending)
+			assert.ErrorIs(t, err, constant.ErrInvalidCursor)
+		})
+	}
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := decodeLoanCursor("not a cursor", constant.LoanSortFieldCreatedAt, true)
+		assert.ErrorIs(t, err, constant.ErrInvalidCursor)
+	})
+}
+
+func TestLoanFilter(t *testing.T) {
+	tm := []struct {
+		name    string
+		req     *model.ListLoansRequest
+		want    *model.LoanFilter
+		wantErr error
+	}{
+		{
+			name: "defaults",
+			req:  &model.ListLoansRequest{UserId: 1, RoleId: constant.RoleIdAdmin},
+			want: &model.LoanFilter{
+				SortBy:     constant.LoanSortFieldCreatedAt,
+				Descending: true,
+				Limit:      constant.DefaultLoanPageSize,
+			},
+		},
+		{
+			name: "borrowerSeesOwnLoansOnly",
+			req:  &model.ListLoansRequest{UserId: 7, RoleId: constant.RoleIdBorrower, BorrowerId: 9, SortBy: "principal_amount", SortOrder: "asc", Limit: 5},
+			want: &model.LoanFilter{
+				BorrowerId: 7,
+				SortBy:     constant.LoanSortFieldPrincipalAmount,
+				Limit:      5,
+			},
+		},
+		{
+			name: "investorSeesMarketplaceAndHoldings",
+			req:  &model.ListLoansRequest{UserId: 3, RoleId: constant.RoleIdInvestor, States: []string{"FUNDING"}, CreatedFrom: "2024-01-01", CreatedTo: "2024-01-31"},
+			want: &model.LoanFilter{
+				States:       []constant.LoanState{constant.LoanStateFunding},
+				CreatedFrom:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				CreatedTo:    time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				InvestorId:   3,
+				MarketStates: marketplaceLoanStates,
+				SortBy:       constant.LoanSortFieldCreatedAt,
+				Descending:   true,
+				Limit:        constant.DefaultLoanPageSize,
+			},
+		},
+		{
+			name: "limitClamped",
+			req:  &model.ListLoansRequest{RoleId: constant.RoleIdAdmin, Limit: math.MaxUint32},
+			want: &model.LoanFilter{
+				SortBy:     constant.LoanSortFieldCreatedAt,
+				Descending: true,
+				Limit:      constant.MaxLoanPageSize,
+			},
+		},
+		{
+			name:    "unknownState",
+			req:     &model.ListLoansRequest{RoleId: constant.RoleIdAdmin, States: []string{"PENDING"}},
+			wantErr: constant.ErrInvalidLoanFilter,
+		},
+		{
+			name:    "reversedPrincipalRange",
+			req:     &model.ListLoansRequest{RoleId: constant.RoleIdAdmin, MinPrincipal: 200 * money.Unit, MaxPrincipal: 100 * money.Unit},
+			wantErr: constant.ErrInvalidLoanFilter,
+		},
+		{
+			name:    "reversedDateRange",
+			req:     &model.ListLoansRequest{RoleId: constant.RoleIdAdmin, CreatedFrom: "2024-02-01", CreatedTo: "2024-01-01"},
+			wantErr: constant.ErrInvalidLoanFilter,
+		},
+		{
+			name:    "cursorOfAnotherSort",
+			req:     &model.ListLoansRequest{RoleId: constant.RoleIdAdmin, SortBy: "interest_rate", Cursor: encodeLoanCursor(&model.Loan{CommonModel: model.CommonModel{Id: 1}}, constant.LoanSortFieldCreatedAt, true)},
+			wantErr: constant.ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loanFilter(tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
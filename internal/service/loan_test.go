@@ -0,0 +1,55 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanApproveStep(t *testing.T) {
+	tm := []struct {
+		name       string
+		roleId     uint8
+		stepRoleId uint8
+		want       bool
+	}{
+		{
+			name:       "sameRole",
+			roleId:     constant.RoleIdFieldValidator,
+			stepRoleId: constant.RoleIdFieldValidator,
+			want:       true,
+		},
+		{
+			name:       "seniorRole",
+			roleId:     constant.RoleIdAdmin,
+			stepRoleId: constant.RoleIdFieldValidator,
+			want:       true,
+		},
+		{
+			name:       "juniorRole",
+			roleId:     constant.RoleIdFieldValidator,
+			stepRoleId: constant.RoleIdAdmin,
+			want:       false,
+		},
+		{
+			name:       "nonStaffRole",
+			roleId:     constant.RoleIdInvestor,
+			stepRoleId: constant.RoleIdInvestor,
+			want:       false,
+		},
+		{
+			name:       "system",
+			roleId:     constant.RoleIdSystem,
+			stepRoleId: constant.RoleIdFieldValidator,
+			want:       false,
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, canApproveStep(tt.roleId, tt.stepRoleId))
+		})
+	}
+}
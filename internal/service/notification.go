@@ -7,6 +7,7 @@ import (
 
 	"github.com/ffauzann/loan-service/internal/constant"
 	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
 	"github.com/ffauzann/loan-service/internal/util"
 )
 
@@ -21,9 +22,81 @@ func (s *service) notifyLoanFullyFunded(ctx context.Context, loanId uint64) (err
 		return
 	}
 
+	return s.notifyInvestors(ctx, constant.TopicFullyInvested, investments, func(_ *model.User, _ string, _ money.Amount) *model.EmailRequest {
+		return &model.EmailRequest{
+			Subject: "Investment Confirmation",
+			Body:    "You have successfully invested",
+		}
+	})
+}
+
+func (s *service) notifyLoanCancelled(ctx context.Context, loanId uint64, investments []*model.LoanInvestment) (err error) {
+	return s.notifyInvestors(ctx, constant.TopicLoanCancelled, investments, func(_ *model.User, currency string, amount money.Amount) *model.EmailRequest {
+		return &model.EmailRequest{
+			Subject: "Loan Cancelled",
+			Body:    fmt.Sprintf("Loan #%d has been cancelled by its borrower. Your investment of %s %s has been reversed.", loanId, currency, amount),
+		}
+	})
+}
+
+func (s *service) notifyLoanExpired(ctx context.Context, loanId uint64, investments []*model.LoanInvestment) (err error) {
+	return s.notifyInvestors(ctx, constant.TopicLoanExpired, investments, func(_ *model.User, currency string, amount money.Amount) *model.EmailRequest {
+		return &model.EmailRequest{
+			Subject: "Loan Expired",
+			Body:    fmt.Sprintf("Loan #%d was not fully funded before its deadline. Your investment of %s %s has been refunded.", loanId, currency, amount),
+		}
+	})
+}
+
+func (s *service) notifyLoanDelinquent(ctx context.Context, loan *model.Loan) (err error) {
+	investments, err := s.repository.db.GetInvestmentsByLoanId(ctx, loan.Id, nil)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if len(investments) == 0 {
+		util.LogContext(ctx).Info(fmt.Sprintf("No investments found for loan ID %d", loan.Id))
+		return
+	}
+
+	return s.notifyInvestors(ctx, constant.TopicLoanDelinquent, investments, func(_ *model.User, currency string, amount money.Amount) *model.EmailRequest {
+		return &model.EmailRequest{
+			Subject: "Missed Loan Payment",
+			Body:    fmt.Sprintf("Loan #%d has missed a scheduled payment and is %d day(s) past due. Your investment of %s %s is affected.", loan.Id, loan.DaysPastDue, currency, amount),
+		}
+	})
+}
+
+func (s *service) notifyLoanRestructured(ctx context.Context, loan *model.Loan, restructure *model.LoanRestructure) (err error) {
+	investments, err := s.repository.db.GetInvestmentsByLoanId(ctx, loan.Id, nil)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if len(investments) == 0 {
+		util.LogContext(ctx).Info(fmt.Sprintf("No investments found for loan ID %d", loan.Id))
+		return
+	}
+
+	return s.notifyInvestors(ctx, constant.TopicLoanRestructured, investments, func(_ *model.User, currency string, amount money.Amount) *model.EmailRequest {
+		return &model.EmailRequest{
+			Subject: "Loan Restructured",
+			Body: fmt.Sprintf("Loan #%d has been restructured to %d month(s) at %.2f%% interest, including %d month(s) of grace period. Your investment of %s %s is affected.",
+				loan.Id, loan.TenorMonths, loan.InterestRate, restructure.GracePeriodMonths, currency, amount),
+		}
+	})
+}
+
+// notifyInvestors publishes one email notification per unique investor of the given investments.
+// The amount passed to fn is the investor's total amount across the given investments, in the currency of the investments.
+func (s *service) notifyInvestors(ctx context.Context, topic string, investments []*model.LoanInvestment, fn func(investor *model.User, currency string, amount money.Amount) *model.EmailRequest) (err error) {
 	// Get user details for each investment
 	var userIds []uint64
+	amounts := make(map[uint64]money.Amount)
+	currencies := make(map[uint64]string)
 	for _, investment := range investments {
+		amounts[investment.InvestorId] += investment.Amount
+		currencies[investment.InvestorId] = investment.Currency
 		if slices.Contains(userIds, investment.InvestorId) {
 			continue // Skip if investor already processed.
 		} else {
@@ -42,14 +115,13 @@ func (s *service) notifyLoanFullyFunded(ctx context.Context, loanId uint64) (err
 
 	// Publish notification to each investor.
 	for _, investor := range investors {
+		email := fn(investor, currencies[investor.Id], amounts[investor.Id])
+		email.From = "loan@service.com"
+		email.To = investor.Email
+
 		if err = s.repository.messaging.Publish(ctx, &model.Message{
-			Topic: constant.TopicFullyInvested,
-			Payload: &model.EmailRequest{
-				From:    "loan@service.com",
-				To:      investor.Email,
-				Subject: "Investment Confirmation",
-				Body:    "You have successfully invested",
-			},
+			Topic:   topic,
+			Payload: email,
 		}); err != nil {
 			util.LogContext(ctx).Error(err.Error())
 			return
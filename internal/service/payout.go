@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// investorReturn returns the part of the repaid interest owed to investors.
+// Investors earn ROI while the borrower pays InterestRate, the remaining spread is kept by the platform.
+func investorReturn(loan *model.Loan, interest money.Amount) money.Amount {
+	if loan.InterestRate <= 0 || interest <= 0 {
+		return 0
+	}
+
+	return min(interest, interest.Mul(new(big.Rat).Quo(decimalRat(loan.ROI), decimalRat(loan.InterestRate))))
+}
+
+// splitProRata splits total across investments in proportion to their amount.
+// The returned shares always sum exactly to total, see money.Amount.Split.
+func splitProRata(total money.Amount, investments []*model.LoanInvestment) []money.Amount {
+	weights := make([]money.Amount, len(investments))
+	for i, investment := range investments {
+		weights[i] = investment.Amount
+	}
+
+	return total.Split(weights)
+}
+
+// buildInvestorPayouts distributes the principal and the investor return of a repayment across the active investments of a loan.
+func buildInvestorPayouts(loan *model.Loan, repayment *model.LoanRepayment, investments []*model.LoanInvestment) []*model.InvestorPayout {
+	principals := splitProRata(repayment.AppliedPrincipal, investments)
+	returns := splitProRata(investorReturn(loan, repayment.AppliedInterest), investments)
+
+	payouts := make([]*model.InvestorPayout, 0, len(investments))
+	for i, investment := range investments {
+		if principals[i] == 0 && returns[i] == 0 {
+			continue
+		}
+
+		payouts = append(payouts, &model.InvestorPayout{
+			LoanId:          loan.Id,
+			RepaymentId:     repayment.Id,
+			InvestmentId:    investment.Id,
+			InvestorId:      investment.InvestorId,
+			PrincipalAmount: principals[i],
+			ReturnAmount:    returns[i],
+			Amount:          principals[i] + returns[i],
+			PaidAt:          repayment.PaymentDate,
+			CommonModel: model.CommonModel{
+				CreatedAt: now(),
+				CreatedBy: repayment.CreatedBy,
+				UpdatedAt: sql.NullTime{Time: now(), Valid: true},
+				UpdatedBy: repayment.CreatedBy,
+			},
+		})
+	}
+
+	return payouts
+}
+
+// publishInvestorPayouts publishes one payout event per investor, merging the payouts of investors holding several investments.
+func (s *service) publishInvestorPayouts(ctx context.Context, currency string, payouts []*model.InvestorPayout) (err error) {
+	var events []*model.InvestorPayoutEvent
+	byInvestor := make(map[uint64]*model.InvestorPayoutEvent)
+	for _, payout := range payouts {
+		event, ok := byInvestor[payout.InvestorId]
+		if !ok {
+			event = &model.InvestorPayoutEvent{
+				LoanId:      payout.LoanId,
+				RepaymentId: payout.RepaymentId,
+				InvestorId:  payout.InvestorId,
+				Currency:    currency,
+				PaidAt:      payout.PaidAt,
+			}
+			byInvestor[payout.InvestorId] = event
+			events = append(events, event)
+		}
+
+		event.PrincipalAmount += payout.PrincipalAmount
+		event.ReturnAmount += payout.ReturnAmount
+		event.Amount += payout.Amount
+	}
+
+	for _, event := range events {
+		if err = s.repository.messaging.Publish(ctx, &model.Message{
+			Topic:   constant.TopicInvestorPayout,
+			Payload: event,
+		}); err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+	}
+
+	return
+}
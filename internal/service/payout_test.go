@@ -0,0 +1,97 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitProRata(t *testing.T) {
+	tm := []struct {
+		name        string
+		total       money.Amount
+		investments []money.Amount
+		want        []money.Amount
+	}{
+		{
+			name:        "even",
+			total:       10_000,
+			investments: []money.Amount{50_000, 50_000},
+			want:        []money.Amount{5_000, 5_000},
+		},
+		{
+			name:        "leftoverCent",
+			total:       10_000,
+			investments: []money.Amount{100_000, 100_000, 100_000},
+			want:        []money.Amount{3_334, 3_333, 3_333},
+		},
+		{
+			name:        "largestRemainder",
+			total:       1_000,
+			investments: []money.Amount{10_000, 20_000, 40_000},
+			want:        []money.Amount{143, 286, 571},
+		},
+		{
+			name:        "zeroTotal",
+			total:       0,
+			investments: []money.Amount{10_000, 20_000},
+			want:        []money.Amount{0, 0},
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			investments := make([]*model.LoanInvestment, 0, len(tt.investments))
+			for _, amount := range tt.investments {
+				investments = append(investments, &model.LoanInvestment{Amount: amount})
+			}
+
+			shares := splitProRata(tt.total, investments)
+			assert.Equal(t, tt.want, shares)
+
+			var sum money.Amount
+			for _, share := range shares {
+				sum += share
+			}
+			assert.Equal(t, tt.total, sum)
+		})
+	}
+}
+
+func TestInvestorReturn(t *testing.T) {
+	tm := []struct {
+		name     string
+		loan     *model.Loan
+		interest money.Amount
+		want     money.Amount
+	}{
+		{
+			name:     "spread",
+			loan:     &model.Loan{InterestRate: 12, ROI: 9},
+			interest: 10_000,
+			want:     7_500,
+		},
+		{
+			name:     "cappedAtInterest",
+			loan:     &model.Loan{InterestRate: 10, ROI: 15},
+			interest: 10_000,
+			want:     10_000,
+		},
+		{
+			name:     "zeroInterestRate",
+			loan:     &model.Loan{InterestRate: 0, ROI: 5},
+			interest: 10_000,
+			want:     0,
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, investorReturn(tt.loan, tt.interest))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"strconv"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// encodePortfolioCursor returns the opaque cursor pointing right after the given investment.
+func encodePortfolioCursor(investmentId uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(investmentId, 10)))
+}
+
+// decodePortfolioCursor returns the investment ID a cursor issued by encodePortfolioCursor points after.
+func decodePortfolioCursor(s string) (investmentId uint64, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, constant.ErrInvalidCursor
+	}
+
+	investmentId, err = strconv.ParseUint(string(b), 10, 64)
+	if err != nil || investmentId == 0 {
+		return 0, constant.ErrInvalidCursor
+	}
+
+	return
+}
+
+// addPortfolioFigures adds src to dst.
+func addPortfolioFigures(dst *model.PortfolioFigures, src model.PortfolioFigures) {
+	dst.Positions += src.Positions
+	dst.InvestedAmount += src.InvestedAmount
+	dst.ExpectedReturn += src.ExpectedReturn
+	dst.ReceivedPrincipal += src.ReceivedPrincipal
+	dst.ReceivedReturn += src.ReceivedReturn
+	dst.OutstandingPrincipal += src.OutstandingPrincipal
+}
+
+// portfolioTotals rolls the per-state summaries up into one total per currency, in order of first appearance.
+func portfolioTotals(summaries []*model.PortfolioStateSummary) (totals []*model.PortfolioTotal) {
+	byCurrency := make(map[string]*model.PortfolioTotal)
+	for _, summary := range summaries {
+		total, ok := byCurrency[summary.Currency]
+		if !ok {
+			total = &model.PortfolioTotal{Currency: summary.Currency}
+			byCurrency[summary.Currency] = total
+			totals = append(totals, total)
+		}
+
+		addPortfolioFigures(&total.PortfolioFigures, summary.PortfolioFigures)
+		total.States = append(total.States, summary)
+	}
+
+	return
+}
+
+func (s *service) GetPortfolio(ctx context.Context, req *model.GetPortfolioRequest) (res *model.GetPortfolioResponse, err error) {
+	// Resume after the cursor.
+	var afterId uint64
+	if req.Cursor != "" {
+		if afterId, err = decodePortfolioCursor(req.Cursor); err != nil {
+			util.LogContext(ctx).Warn(err.Error())
+			return
+		}
+	}
+
+	limit := constant.DefaultPortfolioPageSize
+	if req.Limit > 0 {
+		limit = min(req.Limit, constant.MaxPortfolioPageSize)
+	}
+
+	// Begin tx, totals and holdings are read from the same snapshot.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+		ReadOnly:  true,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get portfolio summaries.
+	summaries, err := s.repository.db.GetPortfolioStateSummaries(ctx, req.InvestorId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Get one holding past the page to know whether there is a next page.
+	holdings, err := s.repository.db.GetPortfolioHoldings(ctx, req.InvestorId, afterId, limit+1, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.GetPortfolioResponse{
+		Totals:   portfolioTotals(summaries),
+		Holdings: holdings,
+	}
+	if uint32(len(holdings)) > limit {
+		res.Holdings = holdings[:limit]
+		res.NextCursor = encodePortfolioCursor(res.Holdings[limit-1].InvestmentId)
+	}
+
+	return
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortfolioCursor(t *testing.T) {
+	id, err := decodePortfolioCursor(encodePortfolioCursor(1234))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1234), id)
+
+	for _, cursor := range []string{"not a cursor", encodePortfolioCursor(0), "YWJj"} {
+		_, err = decodePortfolioCursor(cursor)
+		assert.ErrorIs(t, err, constant.ErrInvalidCursor)
+	}
+}
+
+func TestPortfolioTotals(t *testing.T) {
+	figures := func(positions uint32, invested, received money.Amount) model.PortfolioFigures {
+		return model.PortfolioFigures{
+			Positions:            positions,
+			InvestedAmount:       invested,
+			ExpectedReturn:       invested / 10,
+			ReceivedPrincipal:    received,
+			OutstandingPrincipal: invested - received,
+		}
+	}
+
+	idrFunding := &model.PortfolioStateSummary{PortfolioFigures: figures(2, 1_000*money.Unit, 0), Currency: "IDR", LoanState: constant.LoanStateFunding}
+	idrRepaying := &model.PortfolioStateSummary{PortfolioFigures: figures(3, 3_000*money.Unit, 500*money.Unit), Currency: "IDR", LoanState: constant.LoanStateRepaying}
+	usdRepaying := &model.PortfolioStateSummary{PortfolioFigures: figures(1, 200*money.Unit, 50*money.Unit), Currency: "USD", LoanState: constant.LoanStateRepaying}
+
+	tm := []struct {
+		name      string
+		summaries []*model.PortfolioStateSummary
+		want      []*model.PortfolioTotal
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:      "totalsPerCurrency",
+			summaries: []*model.PortfolioStateSummary{idrFunding, idrRepaying, usdRepaying},
+			want: []*model.PortfolioTotal{
+				{
+					PortfolioFigures: figures(5, 4_000*money.Unit, 500*money.Unit),
+					Currency:         "IDR",
+					States:           []*model.PortfolioStateSummary{idrFunding, idrRepaying},
+				},
+				{
+					PortfolioFigures: figures(1, 200*money.Unit, 50*money.Unit),
+					Currency:         "USD",
+					States:           []*model.PortfolioStateSummary{usdRepaying},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, portfolioTotals(tt.summaries))
+		})
+	}
+}
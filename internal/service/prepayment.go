@@ -0,0 +1,374 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/statemachine"
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// accruedFraction returns the elapsed share of the monthly period ending at dueDate, as of asOf.
+// The period is assumed to start one month before its due date.
+func accruedFraction(dueDate, asOf time.Time) *big.Rat {
+	dueDate = truncateDate(dueDate)
+	periodStart := addMonths(dueDate, -1)
+	elapsed := truncateDate(asOf).Sub(periodStart)
+	period := dueDate.Sub(periodStart)
+
+	switch {
+	case elapsed <= 0:
+		return new(big.Rat)
+	case elapsed >= period:
+		return big.NewRat(1, 1)
+	}
+	return big.NewRat(int64(elapsed), int64(period))
+}
+
+// settlePayoff pays off every unpaid installment as of asOf and returns how the payoff was applied.
+// Installments due by asOf owe their full interest, the current one only the interest accrued so far
+// and later ones no interest at all. Interest that is not owed is waived from the schedule.
+func settlePayoff(installments []*model.LoanInstallment, asOf time.Time) (alloc repaymentAllocation) {
+	today := truncateDate(asOf)
+	currentFound := false
+	for _, installment := range installments {
+		owedShare := big.NewRat(1, 1)
+		if dueDate := truncateDate(installment.DueDate); dueDate.After(today) {
+			owedShare = new(big.Rat)
+			if !currentFound {
+				owedShare = accruedFraction(dueDate, asOf)
+				currentFound = true
+			}
+		}
+		if installment.Status == constant.LoanInstallmentStatusPaid {
+			continue
+		}
+
+		installment.InterestAmount = max(installment.InterestAmount.Mul(owedShare), installment.PaidInterest)
+		alloc.fee += installment.FeeAmount - installment.PaidFee
+		alloc.interest += installment.InterestAmount - installment.PaidInterest
+		alloc.principal += installment.PrincipalAmount - installment.PaidPrincipal
+
+		installment.PaidFee = installment.FeeAmount
+		installment.PaidInterest = installment.InterestAmount
+		installment.PaidPrincipal = installment.PrincipalAmount
+		installment.Status = constant.LoanInstallmentStatusPaid
+		installment.PaidAt = sql.NullTime{Time: asOf, Valid: true}
+		alloc.settled++
+		alloc.updated = append(alloc.updated, installment)
+	}
+
+	return
+}
+
+// quotePayoff returns how a payoff as of asOf would be applied, without touching the installments.
+func quotePayoff(installments []*model.LoanInstallment, asOf time.Time) repaymentAllocation {
+	clones := make([]*model.LoanInstallment, 0, len(installments))
+	for _, installment := range installments {
+		clone := *installment
+		clones = append(clones, &clone)
+	}
+
+	return settlePayoff(clones, asOf)
+}
+
+// reschedulePrepayment applies a principal prepayment to the unpaid installments and re-amortizes them.
+// The loan must be current: every installment due by asOf is paid and no later installment is partially paid.
+// It returns the installments kept in the schedule and the ones dropped when the tenor gets shorter.
+func reschedulePrepayment(loan *model.Loan, installments []*model.LoanInstallment, amount money.Amount, strategy constant.PrepaymentStrategy, asOf time.Time) (kept, dropped []*model.LoanInstallment, err error) {
+	today := truncateDate(asOf)
+	var pending []*model.LoanInstallment
+	for _, installment := range installments {
+		if installment.Status == constant.LoanInstallmentStatusPaid {
+			continue
+		}
+		if installment.Status != constant.LoanInstallmentStatusUnpaid || !truncateDate(installment.DueDate).After(today) {
+			return nil, nil, constant.ErrLoanNotPrepayable
+		}
+		pending = append(pending, installment)
+	}
+	if len(pending) == 0 {
+		return nil, nil, constant.ErrLoanNotPrepayable
+	}
+
+	var balance money.Amount
+	for _, installment := range pending {
+		balance += installment.PrincipalAmount
+	}
+	if amount >= balance {
+		return nil, nil, constant.ErrInvalidPrepaymentAmount // Use a full payoff instead.
+	}
+
+	rate := monthlyRate(loan)
+	newBalance := balance - amount
+	tenor := uint32(len(pending))
+	var payment money.Amount
+	switch strategy {
+	case constant.PrepaymentStrategyReduceTenor:
+		payment = pending[0].PrincipalAmount + pending[0].InterestAmount
+		if n := annuityTenor(newBalance, rate, payment); n > 0 && n < tenor {
+			tenor = n
+		}
+	default:
+		payment = annuityPayment(newBalance, rate, tenor)
+	}
+
+	for i, split := range amortize(newBalance, rate, payment, tenor) {
+		pending[i].PrincipalAmount = split.principal
+		pending[i].InterestAmount = split.interest
+	}
+
+	// The prepaid amount still owes interest for the elapsed part of the current period.
+	pending[0].InterestAmount += amount.Mul(new(big.Rat).Mul(rate, accruedFraction(pending[0].DueDate, asOf)))
+
+	return pending[:tenor], pending[tenor:], nil
+}
+
+// PrepayLoan records an early repayment of a loan.
+// A FULL prepayment settles the payoff amount and closes the loan, any extra amount is returned as excess.
+// A PARTIAL prepayment goes to principal only and the remaining schedule is recalculated using the chosen strategy.
+func (s *service) PrepayLoan(ctx context.Context, req *model.PrepayLoanRequest) (res *model.PrepayLoanResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan ownership.
+	if req.RoleId == constant.RoleIdBorrower && loan.BorrowerId != req.PayerId {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate loan state.
+	event := statemachine.EventPrepay
+	if constant.PrepaymentType(req.Type) == constant.PrepaymentTypeFull {
+		event = statemachine.EventPayOff
+	}
+	if err = statemachine.Loan.Can(loan, event, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get installments.
+	installments, err := s.repository.db.GetInstallmentsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Prepare repayment model.
+	paymentDate := now()
+	repayment := &model.LoanRepayment{
+		LoanId:      loan.Id,
+		PayerId:     req.PayerId,
+		Amount:      req.Amount,
+		Reference:   req.Reference,
+		PaymentDate: paymentDate,
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.PayerId), Valid: true},
+		},
+	}
+
+	// Apply prepayment to the schedule.
+	var kept, dropped []*model.LoanInstallment
+	switch constant.PrepaymentType(req.Type) {
+	case constant.PrepaymentTypeFull:
+		alloc := settlePayoff(installments, paymentDate)
+		payoff := alloc.fee + alloc.interest + alloc.principal
+		if req.Amount < payoff {
+			err = constant.ErrInvalidPrepaymentAmount
+			util.LogContext(ctx).Warn(err.Error())
+			return
+		}
+
+		kept = alloc.updated
+		repayment.AppliedFee = alloc.fee
+		repayment.AppliedInterest = alloc.interest
+		repayment.AppliedPrincipal = alloc.principal
+		repayment.ExcessAmount = req.Amount - payoff
+	default:
+		kept, dropped, err = reschedulePrepayment(loan, installments, req.Amount, constant.PrepaymentStrategy(req.Strategy), paymentDate)
+		if err != nil {
+			util.LogContext(ctx).Warn(err.Error())
+			return
+		}
+
+		repayment.AppliedPrincipal = req.Amount
+		loan.TenorMonths -= uint32(len(dropped))
+	}
+
+	// Update loan state to repaying, or closed once paid off.
+	previous := loan.State
+	if err = statemachine.Loan.Fire(loan, event, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	setAccrualEndDate(loan, previous, paymentDate)
+
+	// Update recalculated installments.
+	for _, installment := range kept {
+		installment.UpdatedBy = sql.NullInt64{Int64: int64(req.PayerId), Valid: true}
+		err = s.repository.db.UpdateLoanInstallment(ctx, installment, tx)
+		if err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+	}
+
+	// Drop installments no longer needed after the tenor got shorter.
+	droppedIds := make([]uint64, 0, len(dropped))
+	for _, installment := range dropped {
+		droppedIds = append(droppedIds, installment.Id)
+	}
+	err = s.repository.db.DeleteLoanInstallments(ctx, droppedIds, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Save repayment record.
+	err = s.repository.db.CreateLoanRepayment(ctx, repayment, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Get active investments of the loan.
+	investments, err := s.repository.db.GetInvestmentsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Distribute prepayment to investors pro-rata.
+	payouts := buildInvestorPayouts(loan, repayment, investments)
+	err = s.repository.db.CreateInvestorPayouts(ctx, payouts, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Update loan state and tenor.
+	loan.UpdatedBy = sql.NullInt64{Int64: int64(req.PayerId), Valid: true}
+	err = s.repository.db.UpdateLoan(ctx, loan, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	if len(payouts) > 0 {
+		// Publish payout event to each investor.
+		go s.publishInvestorPayouts(context.Background(), loan.Currency, payouts)
+	}
+
+	// Construct response.
+	res = &model.PrepayLoanResponse{
+		LoanId:           loan.Id,
+		Currency:         loan.Currency,
+		RepaymentId:      repayment.Id,
+		Type:             req.Type,
+		Strategy:         req.Strategy,
+		State:            string(loan.State),
+		AppliedFee:       repayment.AppliedFee,
+		AppliedInterest:  repayment.AppliedInterest,
+		AppliedPrincipal: repayment.AppliedPrincipal,
+		ExcessAmount:     repayment.ExcessAmount,
+		TenorMonths:      loan.TenorMonths,
+		PaymentDate:      paymentDate,
+	}
+	if loan.State != constant.LoanStateClosed {
+		for _, installment := range kept {
+			res.OutstandingPrincipal += installment.PrincipalAmount
+		}
+		res.NextInstallmentAmount = installmentOutstanding(kept[0])
+	}
+
+	return
+}
+
+// GetPayoffQuote returns the exact amount needed to pay a loan off as of a given date.
+func (s *service) GetPayoffQuote(ctx context.Context, req *model.GetPayoffQuoteRequest) (res *model.GetPayoffQuoteResponse, err error) {
+	// Parse quote date.
+	asOf := now()
+	if req.AsOf != "" {
+		asOf, err = time.Parse(time.DateOnly, req.AsOf)
+		if err != nil {
+			util.LogContext(ctx).Warn(err.Error())
+			return
+		}
+	}
+	if truncateDate(asOf).Before(truncateDate(now())) {
+		err = constant.ErrInvalidQuoteDate
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		ReadOnly: true,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan visibility.
+	if err = s.validateLoanParticipant(ctx, loan, req.UserId, req.RoleId, tx); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate loan state.
+	if err = statemachine.Loan.Can(loan, statemachine.EventPayOff, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get installments.
+	installments, err := s.repository.db.GetInstallmentsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	quote := quotePayoff(installments, asOf)
+	res = &model.GetPayoffQuoteResponse{
+		LoanId:               loan.Id,
+		Currency:             loan.Currency,
+		AsOf:                 truncateDate(asOf),
+		OutstandingPrincipal: quote.principal,
+		AccruedInterest:      quote.interest,
+		OutstandingFee:       quote.fee,
+		PayoffAmount:         quote.fee + quote.interest + quote.principal,
+	}
+
+	return
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+// prepaymentSchedule returns a 12 months schedule of 12,000,000 at 12% whose first installment (due Feb 28) is paid.
+func prepaymentSchedule() (*model.Loan, []*model.LoanInstallment) {
+	loan := &model.Loan{PrincipalAmount: 12_000_000 * money.Unit, InterestRate: 12, TenorMonths: 12}
+	installments := buildRepaymentSchedule(loan, time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC), 1)
+	for i, installment := range installments {
+		installment.Id = uint64(i + 1)
+	}
+	settlePayoff(installments[:1], installments[0].DueDate)
+
+	return loan, installments
+}
+
+func TestQuotePayoff(t *testing.T) {
+	_, installments := prepaymentSchedule()
+	asOf := time.Date(2025, time.March, 14, 9, 0, 0, 0, time.UTC) // 14 of 31 days into the second installment.
+
+	var wantPrincipal money.Amount
+	for _, installment := range installments[1:] {
+		wantPrincipal += installment.PrincipalAmount
+	}
+	wantInterest := installments[1].InterestAmount.Mul(big.NewRat(14, 31))
+	originalInterest := installments[2].InterestAmount
+
+	quote := quotePayoff(installments, asOf)
+	assert.Equal(t, wantPrincipal, quote.principal)
+	assert.Equal(t, wantInterest, quote.interest)
+	assert.Zero(t, quote.fee)
+	assert.Equal(t, uint32(11), quote.settled)
+
+	// Quoting must not touch the schedule.
+	assert.Equal(t, constant.LoanInstallmentStatusUnpaid, installments[1].Status)
+	assert.Equal(t, originalInterest, installments[2].InterestAmount)
+
+	// Settling applies the same amounts and waives interest not owed.
+	alloc := settlePayoff(installments, asOf)
+	assert.Equal(t, quote.principal, alloc.principal)
+	assert.Equal(t, quote.interest, alloc.interest)
+	for _, installment := range installments {
+		assert.Equal(t, constant.LoanInstallmentStatusPaid, installment.Status)
+	}
+	assert.Zero(t, installments[2].InterestAmount)
+}
+
+func TestReschedulePrepayment(t *testing.T) {
+	asOf := time.Date(2025, time.February, 28, 12, 0, 0, 0, time.UTC) // Right after the first installment is paid.
+
+	tm := []struct {
+		name        string
+		strategy    constant.PrepaymentStrategy
+		amount      money.Amount
+		prepare     func(installments []*model.LoanInstallment)
+		wantErr     error
+		wantKept    int
+		wantDropped bool
+	}{
+		{
+			name:     "reduceInstallment",
+			strategy: constant.PrepaymentStrategyReduceInstallment,
+			amount:   3_000_000 * money.Unit,
+			wantKept: 11,
+		},
+		{
+			name:        "reduceTenor",
+			strategy:    constant.PrepaymentStrategyReduceTenor,
+			amount:      3_000_000 * money.Unit,
+			wantKept:    8,
+			wantDropped: true,
+		},
+		{
+			name:     "exceedsBalance",
+			strategy: constant.PrepaymentStrategyReduceTenor,
+			amount:   12_000_000 * money.Unit,
+			wantErr:  constant.ErrInvalidPrepaymentAmount,
+		},
+		{
+			name:     "notCurrent",
+			strategy: constant.PrepaymentStrategyReduceTenor,
+			amount:   1_000_000 * money.Unit,
+			prepare: func(installments []*model.LoanInstallment) {
+				installments[0].Status = constant.LoanInstallmentStatusUnpaid
+			},
+			wantErr: constant.ErrLoanNotPrepayable,
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			loan, installments := prepaymentSchedule()
+			if tt.prepare != nil {
+				tt.prepare(installments)
+			}
+			var balance money.Amount
+			for _, installment := range installments[1:] {
+				balance += installment.PrincipalAmount
+			}
+			payment := installments[1].PrincipalAmount + installments[1].InterestAmount
+
+			kept, dropped, err := reschedulePrepayment(loan, installments, tt.amount, tt.strategy, asOf)
+			assert.Equal(t, tt.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			assert.Len(t, kept, tt.wantKept)
+			assert.Len(t, dropped, 11-tt.wantKept)
+
+			var principal money.Amount
+			for _, installment := range kept {
+				principal += installment.PrincipalAmount
+			}
+			assert.Equal(t, balance-tt.amount, principal)
+
+			first := kept[0].PrincipalAmount + kept[0].InterestAmount
+			if tt.wantDropped {
+				assert.Equal(t, payment, first)
+			} else {
+				assert.Less(t, first, payment)
+			}
+		})
+	}
+}
+
+func TestAnnuityTenor(t *testing.T) {
+	rate := big.NewRat(1, 100)
+	assert.Equal(t, uint32(12), annuityTenor(12_000_000*money.Unit, rate, annuityPayment(12_000_000*money.Unit, rate, 12)))
+	assert.Equal(t, uint32(4), annuityTenor(1_000*money.Unit, new(big.Rat), 300*money.Unit))
+	assert.Equal(t, uint32(0), annuityTenor(1_000*money.Unit, big.NewRat(1, 10), 100*money.Unit))
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/statemachine"
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// repaymentAllocation is the breakdown of a repayment across the installments it was applied to.
+type repaymentAllocation struct {
+	fee       money.Amount
+	interest  money.Amount
+	principal money.Amount
+	excess    money.Amount
+	updated   []*model.LoanInstallment // Installments touched by the repayment.
+	settled   uint32                   // Installments fully paid by the repayment.
+}
+
+// allocateRepayment applies amount to the unpaid installments starting from the oldest one.
+// Within an installment the amount goes to fees first, then interest, then principal.
+// Whatever remains after every installment is paid is returned as excess.
+func allocateRepayment(installments []*model.LoanInstallment, amount money.Amount, paidAt time.Time) (alloc repaymentAllocation) {
+	remaining := amount
+	for _, installment := range installments {
+		if remaining <= 0 {
+			break
+		}
+		if installment.Status == constant.LoanInstallmentStatusPaid {
+			continue
+		}
+
+		fee := applyPortion(&remaining, installment.FeeAmount, &installment.PaidFee)
+		interest := applyPortion(&remaining, installment.InterestAmount, &installment.PaidInterest)
+		principal := applyPortion(&remaining, installment.PrincipalAmount, &installment.PaidPrincipal)
+		if fee+interest+principal == 0 {
+			continue
+		}
+
+		alloc.fee += fee
+		alloc.interest += interest
+		alloc.principal += principal
+
+		installment.Status = constant.LoanInstallmentStatusPartiallyPaid
+		if installmentOutstanding(installment) == 0 {
+			installment.Status = constant.LoanInstallmentStatusPaid
+			installment.PaidAt = sql.NullTime{Time: paidAt, Valid: true}
+			alloc.settled++
+		}
+		alloc.updated = append(alloc.updated, installment)
+	}
+
+	alloc.excess = max(remaining, 0)
+	return
+}
+
+// applyPortion moves as much of remaining as needed to fully pay due into paid and returns the applied amount.
+func applyPortion(remaining *money.Amount, due money.Amount, paid *money.Amount) money.Amount {
+	applied := min(*remaining, due-*paid)
+	if applied <= 0 {
+		return 0
+	}
+
+	*paid += applied
+	*remaining -= applied
+	return applied
+}
+
+// installmentOutstanding returns the unpaid amount of an installment, including fees.
+func installmentOutstanding(installment *model.LoanInstallment) money.Amount {
+	return installment.FeeAmount + installment.InterestAmount + installment.PrincipalAmount -
+		installment.PaidFee - installment.PaidInterest - installment.PaidPrincipal
+}
+
+// RecordRepayment applies a borrower repayment to the schedule of a disbursed loan, distributes it to investors and closes the loan once fully repaid.
+// Partial payments leave the current installment PARTIALLY_PAID, overpayments are returned as excess and not applied.
+func (s *service) RecordRepayment(ctx context.Context, req *model.RecordRepaymentRequest) (res *model.RecordRepaymentResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan ownership.
+	if req.RoleId == constant.RoleIdBorrower && loan.BorrowerId != req.PayerId {
+		err = constant.ErrPermissionDenied
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate loan state. Defaulted and written off loans still accept recovery payments.
+	if err = statemachine.Loan.Can(loan, statemachine.EventRepay, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get installments.
+	installments, err := s.repository.db.GetInstallmentsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Apply repayment to the oldest unpaid installments.
+	paymentDate := now()
+	alloc := allocateRepayment(installments, req.Amount, paymentDate)
+	for _, installment := range alloc.updated {
+		installment.UpdatedBy = sql.NullInt64{Int64: int64(req.PayerId), Valid: true}
+		err = s.repository.db.UpdateLoanInstallment(ctx, installment, tx)
+		if err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+	}
+
+	// Prepare repayment model.
+	repayment := &model.LoanRepayment{
+		LoanId:           loan.Id,
+		PayerId:          req.PayerId,
+		Amount:           req.Amount,
+		AppliedFee:       alloc.fee,
+		AppliedInterest:  alloc.interest,
+		AppliedPrincipal: alloc.principal,
+		ExcessAmount:     alloc.excess,
+		Reference:        req.Reference,
+		PaymentDate:      paymentDate,
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.PayerId), Valid: true},
+		},
+	}
+
+	// Save repayment record.
+	err = s.repository.db.CreateLoanRepayment(ctx, repayment, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Get active investments of the loan.
+	investments, err := s.repository.db.GetInvestmentsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Distribute repayment to investors pro-rata.
+	payouts := buildInvestorPayouts(loan, repayment, investments)
+	err = s.repository.db.CreateInvestorPayouts(ctx, payouts, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Calculate remaining outstanding amount.
+	var outstanding money.Amount
+	for _, installment := range installments {
+		outstanding += installmentOutstanding(installment)
+	}
+
+	// Track recovery of written off loans.
+	if loan.State == constant.LoanStateWrittenOff {
+		err = s.repository.db.AddLoanWriteOffRecovery(ctx, loan.Id, alloc.fee+alloc.interest+alloc.principal, tx)
+		if err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+	}
+
+	// Update loan state to repaying or closed. Written off loans stay written off.
+	event := statemachine.EventRepay
+	if outstanding == 0 && loan.State != constant.LoanStateWrittenOff {
+		event = statemachine.EventClose
+	}
+	previous := loan.State
+	if err = statemachine.Loan.Fire(loan, event, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	setAccrualEndDate(loan, previous, paymentDate)
+	loan.UpdatedBy = sql.NullInt64{Int64: int64(req.PayerId), Valid: true}
+	err = s.repository.db.UpdateLoan(ctx, loan, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	if len(payouts) > 0 {
+		// Publish payout event to each investor.
+		go s.publishInvestorPayouts(context.Background(), loan.Currency, payouts)
+	}
+
+	// Construct response.
+	res = &model.RecordRepaymentResponse{
+		LoanId:                  loan.Id,
+		Currency:                loan.Currency,
+		RepaymentId:             repayment.Id,
+		State:                   string(loan.State),
+		AppliedFee:              alloc.fee,
+		AppliedInterest:         alloc.interest,
+		AppliedPrincipal:        alloc.principal,
+		ExcessAmount:            alloc.excess,
+		OutstandingAmount:       outstanding,
+		SettledInstallmentCount: alloc.settled,
+		PaymentDate:             paymentDate,
+	}
+
+	return
+}
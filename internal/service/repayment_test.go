@@ -0,0 +1,85 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocateRepayment(t *testing.T) {
+	paidAt := time.Date(2025, time.March, 1, 10, 0, 0, 0, time.UTC)
+	schedule := func() []*model.LoanInstallment {
+		return []*model.LoanInstallment{
+			{InstallmentNumber: 1, FeeAmount: 1000, InterestAmount: 10_000, PrincipalAmount: 90_000, Status: constant.LoanInstallmentStatusUnpaid},
+			{InstallmentNumber: 2, InterestAmount: 5000, PrincipalAmount: 95_000, Status: constant.LoanInstallmentStatusUnpaid},
+		}
+	}
+
+	tm := []struct {
+		name          string
+		amount        money.Amount
+		wantFee       money.Amount
+		wantInterest  money.Amount
+		wantPrincipal money.Amount
+		wantExcess    money.Amount
+		wantSettled   uint32
+		wantStatuses  []constant.LoanInstallmentStatus
+	}{
+		{
+			name:         "partial",
+			amount:       6000,
+			wantFee:      1000,
+			wantInterest: 5000,
+			wantStatuses: []constant.LoanInstallmentStatus{constant.LoanInstallmentStatusPartiallyPaid, constant.LoanInstallmentStatusUnpaid},
+		},
+		{
+			name:          "exactInstallment",
+			amount:        101_000,
+			wantFee:       1000,
+			wantInterest:  10_000,
+			wantPrincipal: 90_000,
+			wantSettled:   1,
+			wantStatuses:  []constant.LoanInstallmentStatus{constant.LoanInstallmentStatusPaid, constant.LoanInstallmentStatusUnpaid},
+		},
+		{
+			name:          "spanningInstallments",
+			amount:        110_050,
+			wantFee:       1000,
+			wantInterest:  15_000,
+			wantPrincipal: 94_050,
+			wantSettled:   1,
+			wantStatuses:  []constant.LoanInstallmentStatus{constant.LoanInstallmentStatusPaid, constant.LoanInstallmentStatusPartiallyPaid},
+		},
+		{
+			name:          "overpayment",
+			amount:        250_000,
+			wantFee:       1000,
+			wantInterest:  15_000,
+			wantPrincipal: 185_000,
+			wantExcess:    49_000,
+			wantSettled:   2,
+			wantStatuses:  []constant.LoanInstallmentStatus{constant.LoanInstallmentStatusPaid, constant.LoanInstallmentStatusPaid},
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			installments := schedule()
+			alloc := allocateRepayment(installments, tt.amount, paidAt)
+			assert.Equal(t, tt.wantFee, alloc.fee)
+			assert.Equal(t, tt.wantInterest, alloc.interest)
+			assert.Equal(t, tt.wantPrincipal, alloc.principal)
+			assert.Equal(t, tt.wantExcess, alloc.excess)
+			assert.Equal(t, tt.wantSettled, alloc.settled)
+			for i, installment := range installments {
+				assert.Equal(t, tt.wantStatuses[i], installment.Status)
+				assert.Equal(t, installment.Status == constant.LoanInstallmentStatusPaid, installment.PaidAt.Valid)
+			}
+		})
+	}
+}
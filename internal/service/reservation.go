@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// reservationPeriod returns the configured reservation period, or the default one if it is missing or malformed.
+func (s *service) reservationPeriod() time.Duration {
+	d, err := time.ParseDuration(s.config.Loan.ReservationPeriod)
+	if err != nil || d <= 0 {
+		return constant.DefaultReservationPeriod
+	}
+
+	return d
+}
+
+// loanCapacity returns the part of the principal of a loan neither invested nor held by reservations.
+// held is the amount of the reservation being confirmed, which stays available to its own investor.
+func loanCapacity(loan *model.Loan, reserved, held money.Amount) money.Amount {
+	return loan.PrincipalAmount - loan.InvestedAmount - (reserved - held)
+}
+
+// ReserveInvestment holds part of the remaining capacity of a loan for the investor until the reservation period ends.
+// The reservation goes through the same checks as InvestInLoan and the held amount is unavailable to other investors meanwhile.
+func (s *service) ReserveInvestment(ctx context.Context, req *model.ReserveInvestmentRequest) (res *model.ReserveInvestmentResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate investment.
+	available, err := s.validateInvestment(ctx, loan, &model.InvestInLoanRequest{
+		LoanId:     req.LoanId,
+		InvestorId: req.InvestorId,
+		RoleId:     req.RoleId,
+		Amount:     req.Amount,
+		Currency:   req.Currency,
+	}, 0, tx)
+	if err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Prepare reservation model.
+	reservation := &model.LoanInvestmentReservation{
+		LoanId:     loan.Id,
+		InvestorId: req.InvestorId,
+		Amount:     req.Amount,
+		Currency:   loan.Currency,
+		Status:     constant.LoanInvestmentReservationStatusHeld,
+		ExpiresAt:  now().Add(s.reservationPeriod()),
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.InvestorId), Valid: true},
+			UpdatedAt: sql.NullTime{Time: now(), Valid: true},
+			UpdatedBy: sql.NullInt64{Int64: int64(req.InvestorId), Valid: true},
+		},
+	}
+
+	// Save reservation record.
+	err = s.repository.db.CreateLoanInvestmentReservation(ctx, reservation, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.ReserveInvestmentResponse{
+		ReservationId:   reservation.Id,
+		LoanId:          reservation.LoanId,
+		Amount:          reservation.Amount,
+		Currency:        reservation.Currency,
+		ExpiresAt:       reservation.ExpiresAt,
+		AvailableAmount: available - reservation.Amount,
+	}
+
+	return
+}
+
+// ConfirmInvestment turns a held reservation into an investment, re-running the checks of InvestInLoan against the current loan.
+func (s *service) ConfirmInvestment(ctx context.Context, req *model.ConfirmInvestmentRequest) (res *model.ConfirmInvestmentResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get reservation by ID.
+	reservation, err := s.repository.db.GetLoanInvestmentReservationById(ctx, req.ReservationId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Reservations of other investors are reported as not found.
+	if reservation.InvestorId != req.InvestorId {
+		err = constant.ErrReservationNotFound
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate reservation status and expiry.
+	if reservation.Status != constant.LoanInvestmentReservationStatusHeld {
+		err = constant.ErrReservationNotHeld
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if !reservation.ExpiresAt.After(now()) {
+		err = constant.ErrReservationExpired
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, reservation.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate investment, the reserved amount being held for it.
+	investReq := &model.InvestInLoanRequest{
+		LoanId:     reservation.LoanId,
+		InvestorId: reservation.InvestorId,
+		RoleId:     req.RoleId,
+		Amount:     reservation.Amount,
+		Currency:   reservation.Currency,
+	}
+	if _, err = s.validateInvestment(ctx, loan, investReq, reservation.Amount, tx); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Save investment and update loan.
+	investment, err := s.placeInvestment(ctx, loan, investReq, tx)
+	if err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Mark reservation as confirmed.
+	reservation.Status = constant.LoanInvestmentReservationStatusConfirmed
+	reservation.InvestmentId = sql.NullInt64{Int64: int64(investment.Id), Valid: true}
+	reservation.ConfirmedAt = sql.NullTime{Time: now(), Valid: true}
+	reservation.UpdatedBy = sql.NullInt64{Int64: int64(req.InvestorId), Valid: true}
+	err = s.repository.db.UpdateLoanInvestmentReservation(ctx, reservation, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.ConfirmInvestmentResponse{
+		ReservationId:  reservation.Id,
+		InvestmentId:   investment.Id,
+		LoanId:         loan.Id,
+		InvestedAmount: investment.Amount,
+		Currency:       loan.Currency,
+		State:          string(loan.State),
+	}
+
+	return
+}
+
+// ReleaseExpiredReservations marks every held reservation past its expiry as EXPIRED.
+// Expired reservations stop counting toward the capacity of their loan as soon as they expire, this only records it.
+func (s *service) ReleaseExpiredReservations(ctx context.Context) (res *model.ReleaseExpiredReservationsResponse, err error) {
+	// Expire held reservations.
+	reservationIds, err := s.repository.db.ExpireLoanInvestmentReservations(ctx, now())
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.ReleaseExpiredReservationsResponse{
+		ReleasedReservationIds: reservationIds,
+	}
+
+	return
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoanCapacity(t *testing.T) {
+	loan := &model.Loan{PrincipalAmount: 10_000_000 * money.Unit, InvestedAmount: 6_000_000 * money.Unit}
+
+	tm := []struct {
+		name     string
+		reserved money.Amount
+		held     money.Amount
+		want     money.Amount
+	}{
+		{
+			name: "noReservation",
+			want: 4_000_000 * money.Unit,
+		},
+		{
+			name:     "lastSliceReserved",
+			reserved: 4_000_000 * money.Unit,
+			want:     0,
+		},
+		{
+			name:     "confirmingLastSlice",
+			reserved: 4_000_000 * money.Unit,
+			held:     4_000_000 * money.Unit,
+			want:     4_000_000 * money.Unit,
+		},
+		{
+			name:     "confirmingNextToOtherReservation",
+			reserved: 3_000_000 * money.Unit,
+			held:     1_000_000 * money.Unit,
+			want:     2_000_000 * money.Unit,
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, loanCapacity(loan, tt.reserved, tt.held))
+		})
+	}
+}
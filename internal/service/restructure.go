@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/statemachine"
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// restructureSchedule replaces the unpaid installments of a loan with a new schedule starting one month after asOf.
+// Unpaid principal and arrears (interest due by asOf and unpaid fees) are capitalized into the new principal,
+// interest not yet due is dropped. The first GracePeriodMonths installments are interest-only, followed by an annuity
+// over the remaining installments extended by ExtendTenorMonths.
+func restructureSchedule(installments []*model.LoanInstallment, restructure *model.LoanRestructure, asOf time.Time, createdBy uint64) (replaced, created []*model.LoanInstallment, capitalized money.Amount) {
+	today := truncateDate(asOf)
+	var lastPaidNumber uint32
+	var principal money.Amount
+	for _, installment := range installments {
+		if installment.Status == constant.LoanInstallmentStatusPaid {
+			lastPaidNumber = max(lastPaidNumber, installment.InstallmentNumber)
+			continue
+		}
+
+		principal += installment.PrincipalAmount - installment.PaidPrincipal
+		capitalized += installment.FeeAmount - installment.PaidFee
+		if !truncateDate(installment.DueDate).After(today) {
+			capitalized += installment.InterestAmount - installment.PaidInterest
+		}
+		replaced = append(replaced, installment)
+	}
+	if len(replaced) == 0 {
+		return nil, nil, 0
+	}
+
+	principal += capitalized
+	rate := monthlyRateOf(restructure.NewInterestRate)
+	tenor := uint32(len(replaced)) + restructure.ExtendTenorMonths
+
+	splits := make([]amortization, 0, restructure.GracePeriodMonths+tenor)
+	for i := uint32(0); i < restructure.GracePeriodMonths; i++ {
+		splits = append(splits, amortization{interest: principal.Mul(rate)})
+	}
+	splits = append(splits, amortize(principal, rate, annuityPayment(principal, rate, tenor), tenor)...)
+
+	for i, split := range splits {
+		created = append(created, &model.LoanInstallment{
+			LoanId:            restructure.LoanId,
+			InstallmentNumber: lastPaidNumber + uint32(i) + 1,
+			DueDate:           addMonths(today, i+1),
+			PrincipalAmount:   split.principal,
+			InterestAmount:    split.interest,
+			Status:            constant.LoanInstallmentStatusUnpaid,
+			CommonModel: model.CommonModel{
+				CreatedAt: now(),
+				CreatedBy: sql.NullInt64{Int64: int64(createdBy), Valid: true},
+				UpdatedAt: sql.NullTime{Time: now(), Valid: true},
+				UpdatedBy: sql.NullInt64{Int64: int64(createdBy), Valid: true},
+			},
+		})
+	}
+
+	return replaced, created, capitalized
+}
+
+// RestructureLoan raises a restructure request on an active loan. The schedule is only replaced once another admin approves it.
+func (s *service) RestructureLoan(ctx context.Context, req *model.RestructureLoanRequest) (res *model.RestructureLoanResponse, err error) {
+	// Validate restructure terms.
+	if req.ExtendTenorMonths == 0 && req.GracePeriodMonths == 0 && req.InterestRate == nil {
+		err = constant.ErrInvalidRestructure
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan state.
+	if err = statemachine.Loan.Can(loan, statemachine.EventRestructure, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate there is no pending restructure.
+	isPending, err := s.repository.db.HasPendingLoanRestructure(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if isPending {
+		err = constant.ErrLoanRestructurePending
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Prepare restructure model.
+	restructure := &model.LoanRestructure{
+		LoanId:               loan.Id,
+		Status:               constant.LoanRestructureStatusPending,
+		ExtendTenorMonths:    req.ExtendTenorMonths,
+		GracePeriodMonths:    req.GracePeriodMonths,
+		PreviousInterestRate: loan.InterestRate,
+		NewInterestRate:      loan.InterestRate,
+		PreviousTenorMonths:  loan.TenorMonths,
+		NewTenorMonths:       loan.TenorMonths + req.ExtendTenorMonths + req.GracePeriodMonths,
+		Reason:               req.Reason,
+		RequestedBy:          req.AdminId,
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.AdminId), Valid: true},
+			UpdatedAt: sql.NullTime{Time: now(), Valid: true},
+			UpdatedBy: sql.NullInt64{Int64: int64(req.AdminId), Valid: true},
+		},
+	}
+	if req.InterestRate != nil {
+		restructure.NewInterestRate = *req.InterestRate
+	}
+
+	// Save restructure request.
+	err = s.repository.db.CreateLoanRestructure(ctx, restructure, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.RestructureLoanResponse{
+		RestructureId:     restructure.Id,
+		LoanId:            loan.Id,
+		Status:            string(restructure.Status),
+		ExtendTenorMonths: restructure.ExtendTenorMonths,
+		GracePeriodMonths: restructure.GracePeriodMonths,
+		InterestRate:      restructure.NewInterestRate,
+		TenorMonths:       restructure.NewTenorMonths,
+		RequestedAt:       restructure.CreatedAt,
+	}
+
+	return
+}
+
+// ReviewLoanRestructure approves or rejects a pending restructure.
+// On approval the current schedule is archived and its unpaid part is replaced by the restructured one.
+func (s *service) ReviewLoanRestructure(ctx context.Context, req *model.ReviewLoanRestructureRequest) (res *model.ReviewLoanRestructureResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get restructure by ID.
+	restructure, err := s.repository.db.GetLoanRestructureById(ctx, req.RestructureId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate restructure status and reviewer.
+	if restructure.Status != constant.LoanRestructureStatusPending {
+		err = constant.ErrRestructureNotPending
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if restructure.RequestedBy == req.ReviewerId {
+		err = constant.ErrRestructureSelfReview
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, restructure.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	restructure.Status = constant.LoanRestructureStatus(req.Decision)
+	restructure.ReviewedBy = sql.NullInt64{Int64: int64(req.ReviewerId), Valid: true}
+	restructure.ReviewNote = req.Note
+	restructure.ReviewedAt = sql.NullTime{Time: now(), Valid: true}
+	restructure.UpdatedBy = sql.NullInt64{Int64: int64(req.ReviewerId), Valid: true}
+
+	res = &model.ReviewLoanRestructureResponse{
+		RestructureId: restructure.Id,
+		LoanId:        loan.Id,
+		Currency:      loan.Currency,
+		Status:        string(restructure.Status),
+		ReviewedAt:    restructure.ReviewedAt.Time,
+	}
+
+	var created []*model.LoanInstallment
+	if restructure.Status == constant.LoanRestructureStatusApproved {
+		// Validate loan state.
+		if err = statemachine.Loan.Can(loan, statemachine.EventRestructure, req.RoleId); err != nil {
+			util.LogContext(ctx).Warn(err.Error())
+			return
+		}
+
+		// Accrue the days missed so far at the current terms, before the restructure changes them.
+		if _, err = s.postLoanAccruals(ctx, loan, truncateDate(now()).AddDate(0, 0, -1), tx); err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+
+		// Get installments.
+		var installments []*model.LoanInstallment
+		installments, err = s.repository.db.GetInstallmentsByLoanId(ctx, loan.Id, tx)
+		if err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+
+		// Keep the current schedule as history.
+		err = s.repository.db.ArchiveLoanInstallments(ctx, loan.Id, restructure.Id, tx)
+		if err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+
+		// Replace unpaid installments with the restructured ones.
+		var replaced []*model.LoanInstallment
+		replaced, created, restructure.CapitalizedAmount = restructureSchedule(installments, restructure, now(), req.ReviewerId)
+		replacedIds := make([]uint64, 0, len(replaced))
+		for _, installment := range replaced {
+			replacedIds = append(replacedIds, installment.Id)
+		}
+		err = s.repository.db.DeleteLoanInstallments(ctx, replacedIds, tx)
+		if err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+		err = s.repository.db.CreateLoanInstallments(ctx, created, tx)
+		if err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+
+		// Update loan terms. Arrears are capitalized so the loan is current again.
+		loan.InterestRate = restructure.NewInterestRate
+		loan.TenorMonths = uint32(len(installments) - len(replaced) + len(created))
+		loan.DaysPastDue = 0
+		loan.DelinquencyBucket = constant.LoanDelinquencyBucketCurrent
+		previous := loan.State
+		if err = statemachine.Loan.Fire(loan, statemachine.EventRestructure, req.RoleId); err != nil {
+			util.LogContext(ctx).Warn(err.Error())
+			return
+		}
+		setAccrualEndDate(loan, previous, now())
+		loan.UpdatedBy = sql.NullInt64{Int64: int64(req.ReviewerId), Valid: true}
+		err = s.repository.db.UpdateLoan(ctx, loan, tx)
+		if err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+
+		restructure.NewTenorMonths = loan.TenorMonths
+		res.CapitalizedAmount = restructure.CapitalizedAmount
+		if int(restructure.GracePeriodMonths) < len(created) {
+			first := created[restructure.GracePeriodMonths]
+			res.InstallmentAmount = first.PrincipalAmount + first.InterestAmount
+		}
+	}
+
+	// Save review outcome.
+	err = s.repository.db.UpdateLoanRestructure(ctx, restructure, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	if restructure.Status == constant.LoanRestructureStatusApproved {
+		// Notify investors about the new terms.
+		go s.notifyLoanRestructured(context.Background(), loan, restructure)
+	}
+
+	// Construct response.
+	res.LoanState = string(loan.State)
+	res.InterestRate = loan.InterestRate
+	res.TenorMonths = loan.TenorMonths
+
+	return
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestructureSchedule(t *testing.T) {
+	asOf := time.Date(2025, time.April, 10, 9, 0, 0, 0, time.UTC) // The second installment (due Mar 31) is missed.
+
+	tm := []struct {
+		name            string
+		restructure     *model.LoanRestructure
+		wantCreated     int
+		wantGraceAmount money.Amount
+	}{
+		{
+			name:        "extendTenor",
+			restructure: &model.LoanRestructure{NewInterestRate: 12, ExtendTenorMonths: 6},
+			wantCreated: 17,
+		},
+		{
+			name:        "lowerRate",
+			restructure: &model.LoanRestructure{NewInterestRate: 6},
+			wantCreated: 11,
+		},
+		{
+			name:        "gracePeriod",
+			restructure: &model.LoanRestructure{NewInterestRate: 12, GracePeriodMonths: 3},
+			wantCreated: 14,
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			_, installments := prepaymentSchedule()
+			installments[1].FeeAmount = 50_000 * money.Unit
+
+			var wantPrincipal money.Amount
+			for _, installment := range installments[1:] {
+				wantPrincipal += installment.PrincipalAmount
+			}
+			wantCapitalized := installments[1].InterestAmount + installments[1].FeeAmount
+
+			replaced, created, capitalized := restructureSchedule(installments, tt.restructure, asOf, 1)
+			assert.Len(t, replaced, 11)
+			assert.Len(t, created, tt.wantCreated)
+			assert.Equal(t, wantCapitalized, capitalized)
+
+			// Numbering continues after the paid installment, due dates start a month after asOf.
+			assert.Equal(t, uint32(2), created[0].InstallmentNumber)
+			assert.Equal(t, time.Date(2025, time.May, 10, 0, 0, 0, 0, time.UTC), created[0].DueDate)
+
+			var principal money.Amount
+			for i, installment := range created {
+				assert.Equal(t, constant.LoanInstallmentStatusUnpaid, installment.Status)
+				if i < int(tt.restructure.GracePeriodMonths) {
+					assert.Zero(t, installment.PrincipalAmount)
+					assert.Equal(t, (wantPrincipal + wantCapitalized).Mul(big.NewRat(1, 100)), installment.InterestAmount)
+				}
+				principal += installment.PrincipalAmount
+			}
+			assert.Equal(t, wantPrincipal+wantCapitalized, principal)
+		})
+	}
+}
+
+func TestRestructureScheduleFullyPaid(t *testing.T) {
+	_, installments := prepaymentSchedule()
+	settlePayoff(installments, installments[len(installments)-1].DueDate)
+
+	replaced, created, capitalized := restructureSchedule(installments, &model.LoanRestructure{NewInterestRate: 12, ExtendTenorMonths: 6}, time.Now(), 1)
+	assert.Empty(t, replaced)
+	assert.Empty(t, created)
+	assert.Zero(t, capitalized)
+}
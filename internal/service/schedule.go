@@ -0,0 +1,145 @@
+package service
+
+import (
+	"database/sql"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+)
+
+// buildRepaymentSchedule splits a loan into equal monthly installments (annuity) starting one month after startDate.
+// Interest accrues monthly on the outstanding principal using the annual InterestRate of the loan.
+// Interest is computed exactly and rounded to cents, the last installment absorbs the rounding remainder,
+// so principal portions always sum up exactly to the loan principal.
+func buildRepaymentSchedule(loan *model.Loan, startDate time.Time, createdBy uint64) []*model.LoanInstallment {
+	tenor := loan.TenorMonths
+	if tenor == 0 {
+		tenor = constant.DefaultLoanTenorMonths
+	}
+
+	rate := monthlyRate(loan)
+	splits := amortize(loan.PrincipalAmount, rate, annuityPayment(loan.PrincipalAmount, rate, tenor), tenor)
+
+	installments := make([]*model.LoanInstallment, 0, tenor)
+	for i, split := range splits {
+		number := uint32(i + 1)
+		installments = append(installments, &model.LoanInstallment{
+			LoanId:            loan.Id,
+			InstallmentNumber: number,
+			DueDate:           addMonths(startDate, int(number)),
+			PrincipalAmount:   split.principal,
+			InterestAmount:    split.interest,
+			Status:            constant.LoanInstallmentStatusUnpaid,
+			CommonModel: model.CommonModel{
+				CreatedAt: now(),
+				CreatedBy: sql.NullInt64{Int64: int64(createdBy), Valid: true},
+				UpdatedAt: sql.NullTime{Time: now(), Valid: true},
+				UpdatedBy: sql.NullInt64{Int64: int64(createdBy), Valid: true},
+			},
+		})
+	}
+
+	return installments
+}
+
+// amortization is the principal and interest portion of a single installment.
+type amortization struct {
+	principal money.Amount
+	interest  money.Amount
+}
+
+// monthlyRate converts the annual InterestRate of a loan to an exact monthly rate.
+func monthlyRate(loan *model.Loan) *big.Rat {
+	return monthlyRateOf(loan.InterestRate)
+}
+
+// monthlyRateOf converts an annual percentage rate to an exact monthly rate.
+func monthlyRateOf(annualRate float64) *big.Rat {
+	return new(big.Rat).Quo(decimalRat(annualRate), big.NewRat(1200, 1)) //nolint
+}
+
+// decimalRat returns the exact value of the decimal a rate is written as, so 12.1 is 121/10 rather than its binary approximation.
+func decimalRat(f float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+	return r
+}
+
+// annuityPayment returns the equal monthly payment repaying balance over tenor months, rounded to cents.
+func annuityPayment(balance money.Amount, monthlyRate *big.Rat, tenor uint32) money.Amount {
+	if monthlyRate.Sign() <= 0 {
+		return money.FromRat(new(big.Rat).Quo(balance.Rat(), big.NewRat(int64(tenor), 1)))
+	}
+
+	growth := new(big.Rat).Add(big.NewRat(1, 1), monthlyRate)
+	factor := big.NewRat(1, 1)
+	for i := uint32(0); i < tenor; i++ {
+		factor.Mul(factor, growth)
+	}
+	return levelPayment(balance, monthlyRate, factor)
+}
+
+// levelPayment returns balance * r * (1+r)^n / ((1+r)^n - 1) rounded to cents, factor being (1+r)^n.
+func levelPayment(balance money.Amount, monthlyRate, factor *big.Rat) money.Amount {
+	payment := new(big.Rat).Mul(balance.Rat(), monthlyRate)
+	payment.Mul(payment, factor)
+	payment.Quo(payment, new(big.Rat).Sub(factor, big.NewRat(1, 1)))
+
+	return money.FromRat(payment)
+}
+
+// annuityTenor returns how many monthly payments are needed to repay balance, the last one being possibly smaller.
+// It is the shortest tenor whose annuity payment does not exceed payment, or zero if payment does not even cover the interest.
+func annuityTenor(balance money.Amount, monthlyRate *big.Rat, payment money.Amount) uint32 {
+	if balance <= 0 || payment <= 0 || payment <= balance.Mul(monthlyRate) {
+		return 0
+	}
+	if monthlyRate.Sign() <= 0 {
+		return uint32((balance + payment - 1) / payment)
+	}
+
+	growth := new(big.Rat).Add(big.NewRat(1, 1), monthlyRate)
+	factor := big.NewRat(1, 1)
+	for tenor := uint32(1); tenor <= maxAnnuityTenor; tenor++ {
+		factor.Mul(factor, growth)
+		if levelPayment(balance, monthlyRate, factor) <= payment {
+			return tenor
+		}
+	}
+	return 0
+}
+
+// maxAnnuityTenor bounds the search of annuityTenor.
+const maxAnnuityTenor = 1200
+
+// amortize splits balance into tenor installments of payment, the last installment absorbing the remainder.
+func amortize(balance money.Amount, monthlyRate *big.Rat, payment money.Amount, tenor uint32) []amortization {
+	splits := make([]amortization, 0, tenor)
+	for i := uint32(1); i <= tenor; i++ {
+		interest := balance.Mul(monthlyRate)
+		principal := payment - interest
+		if i == tenor || principal > balance {
+			principal = balance
+		}
+		balance -= principal
+
+		splits = append(splits, amortization{principal: principal, interest: interest})
+	}
+
+	return splits
+}
+
+// addMonths adds n calendar months to t, clamping the day to the end of the target month (Jan 31 + 1 month = Feb 28/29).
+func addMonths(t time.Time, n int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, n, 0)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), day, 0, 0, 0, 0, t.Location())
+}
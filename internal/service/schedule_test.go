@@ -0,0 +1,98 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildRepaymentSchedule(t *testing.T) {
+	startDate := time.Date(2025, time.January, 31, 10, 0, 0, 0, time.UTC)
+
+	tm := []struct {
+		name             string
+		loan             *model.Loan
+		wantTenor        int
+		wantPayment      money.Amount
+		wantFirstDue     time.Time
+		wantLastDue      time.Time
+		wantZeroInterest bool
+	}{
+		{
+			name: "annuity",
+			loan: &model.Loan{
+				PrincipalAmount: 12_000_000 * money.Unit,
+				InterestRate:    12,
+				TenorMonths:     12,
+			},
+			wantTenor:    12,
+			wantPayment:  106_618_546,
+			wantFirstDue: time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC),
+			wantLastDue:  time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "zeroInterest",
+			loan: &model.Loan{
+				PrincipalAmount: 1_000_000 * money.Unit,
+				InterestRate:    0,
+				TenorMonths:     3,
+			},
+			wantTenor:        3,
+			wantPayment:      33_333_333,
+			wantFirstDue:     time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC),
+			wantLastDue:      time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC),
+			wantZeroInterest: true,
+		},
+		{
+			name: "defaultTenor",
+			loan: &model.Loan{
+				PrincipalAmount: 5_000_000 * money.Unit,
+				InterestRate:    10,
+			},
+			wantTenor:    int(constant.DefaultLoanTenorMonths),
+			wantPayment:  43_957_944,
+			wantFirstDue: time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC),
+			wantLastDue:  time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			installments := buildRepaymentSchedule(tt.loan, startDate, 1)
+			assert.Len(t, installments, tt.wantTenor)
+			assert.Equal(t, tt.wantFirstDue, installments[0].DueDate)
+			assert.Equal(t, tt.wantLastDue, installments[len(installments)-1].DueDate)
+			assert.Equal(t, tt.wantPayment, installments[0].PrincipalAmount+installments[0].InterestAmount)
+
+			var totalPrincipal money.Amount
+			for i, installment := range installments {
+				assert.Equal(t, uint32(i+1), installment.InstallmentNumber)
+				assert.Equal(t, constant.LoanInstallmentStatusUnpaid, installment.Status)
+				if tt.wantZeroInterest {
+					assert.Zero(t, installment.InterestAmount)
+				}
+				totalPrincipal += installment.PrincipalAmount
+			}
+			assert.Equal(t, tt.loan.PrincipalAmount, totalPrincipal)
+		})
+	}
+}
+
+func TestApprovedLoanSchedule(t *testing.T) {
+	loan := &model.Loan{
+		PrincipalAmount: 12_000_000 * money.Unit,
+		TenorMonths:     12,
+	}
+	setApprovalTerms(loan, &model.ApproveLoanRequest{InterestRate: 12, ROI: 10})
+
+	installments := buildRepaymentSchedule(loan, time.Date(2025, time.January, 31, 10, 0, 0, 0, time.UTC), 1)
+	assert.Len(t, installments, 12)
+	for _, installment := range installments {
+		assert.Positive(t, installment.InterestAmount)
+	}
+}
@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// tradableLoanStates are the states in which investments may change hands on the secondary market.
+var tradableLoanStates = []constant.LoanState{
+	constant.LoanStateDisbursed,
+	constant.LoanStateRepaying,
+}
+
+// validListingAmount reports whether selling amount out of a position leaves both the sold part and the remainder, if any,
+// at or above the minimum investment of the currency.
+func validListingAmount(position, amount money.Amount, currency currencyLimits) bool {
+	if amount > position || amount < currency.minInvestment {
+		return false
+	}
+
+	remainder := position - amount
+	return remainder == 0 || remainder >= currency.minInvestment
+}
+
+// CreateInvestmentListing offers all or part of an active investment on the secondary market at a price.
+func (s *service) CreateInvestmentListing(ctx context.Context, req *model.CreateInvestmentListingRequest) (res *model.CreateInvestmentListingResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get investment by ID.
+	investment, err := s.repository.db.GetLoanInvestmentById(ctx, req.InvestmentId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Investments of other investors are reported as not found.
+	if investment.InvestorId != req.SellerId {
+		err = constant.ErrInvestmentNotFound
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if investment.Status != constant.LoanInvestmentStatusActive {
+		err = constant.ErrInvestmentNotActive
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, investment.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan state.
+	if !slices.Contains(tradableLoanStates, loan.State) {
+		err = constant.ErrLoanNotTradable
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate listing amount.
+	currency, err := s.currency(loan.Currency)
+	if err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if !validListingAmount(investment.Amount, req.Amount, currency) {
+		err = constant.ErrInvalidListingAmount
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate the investment is not listed yet.
+	isListed, err := s.repository.db.HasOpenInvestmentListing(ctx, investment.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if isListed {
+		err = constant.ErrInvestmentAlreadyListed
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Prepare listing model.
+	listing := &model.InvestmentListing{
+		InvestmentId: investment.Id,
+		LoanId:       loan.Id,
+		SellerId:     req.SellerId,
+		Amount:       req.Amount,
+		Price:        req.Price,
+		Currency:     loan.Currency,
+		Status:       constant.InvestmentListingStatusOpen,
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.SellerId), Valid: true},
+			UpdatedAt: sql.NullTime{Time: now(), Valid: true},
+			UpdatedBy: sql.NullInt64{Int64: int64(req.SellerId), Valid: true},
+		},
+	}
+
+	// Create listing.
+	err = s.repository.db.CreateInvestmentListing(ctx, listing, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.CreateInvestmentListingResponse{
+		Listing: listing,
+	}
+
+	return
+}
+
+// CancelInvestmentListing withdraws an open listing from the secondary market.
+func (s *service) CancelInvestmentListing(ctx context.Context, req *model.CancelInvestmentListingRequest) (res *model.CancelInvestmentListingResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get listing by ID.
+	listing, err := s.repository.db.GetInvestmentListingById(ctx, req.ListingId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Listings of other investors are reported as not found.
+	if listing.SellerId != req.SellerId {
+		err = constant.ErrListingNotFound
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if listing.Status != constant.InvestmentListingStatusOpen {
+		err = constant.ErrListingNotOpen
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Close listing.
+	listing.Status = constant.InvestmentListingStatusCancelled
+	listing.ClosedAt = sql.NullTime{Time: now(), Valid: true}
+	listing.UpdatedBy = sql.NullInt64{Int64: int64(req.SellerId), Valid: true}
+	err = s.repository.db.UpdateInvestmentListing(ctx, listing, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.CancelInvestmentListingResponse{
+		Listing: listing,
+	}
+
+	return
+}
+
+// BuyInvestmentListing transfers a listed position to the buyer within a single transaction.
+// The investment of the seller is closed as TRANSFERRED and replaced by an investment of the buyer for the listed amount
+// and, for partial listings, an investment of the seller for the remainder, so every later payout goes to the current holders.
+func (s *service) BuyInvestmentListing(ctx context.Context, req *model.BuyInvestmentListingRequest) (res *model.BuyInvestmentListingResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get listing by ID.
+	listing, err := s.repository.db.GetInvestmentListingById(ctx, req.ListingId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate listing status and buyer.
+	if listing.Status != constant.InvestmentListingStatusOpen {
+		err = constant.ErrListingNotOpen
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if listing.SellerId == req.BuyerId {
+		err = constant.ErrOwnListing
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, listing.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan state.
+	if !slices.Contains(tradableLoanStates, loan.State) {
+		err = constant.ErrLoanNotTradable
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get listed investment.
+	source, err := s.repository.db.GetLoanInvestmentById(ctx, listing.InvestmentId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	if source.Status != constant.LoanInvestmentStatusActive {
+		err = constant.ErrInvestmentNotActive
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Validate buyer concentration.
+	currency, err := s.currency(loan.Currency)
+	if err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	if err = s.checkConcentration(ctx, loan, req.BuyerId, listing.Amount, currency, tx); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Close the investment of the seller.
+	source.Status = constant.LoanInvestmentStatusTransferred
+	source.ReversedAt = sql.NullTime{Time: now(), Valid: true}
+	err = s.repository.db.UpdateLoanInvestmentStatus(ctx, source, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Open the investment of the buyer.
+	bought := &model.LoanInvestment{
+		LoanId:     loan.Id,
+		InvestorId: req.BuyerId,
+		Amount:     listing.Amount,
+		Currency:   loan.Currency,
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.BuyerId), Valid: true},
+		},
+	}
+	err = s.repository.db.CreateLoanInvestment(ctx, bought, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Prepare transfer model.
+	transfer := &model.InvestmentTransfer{
+		ListingId:          listing.Id,
+		LoanId:             loan.Id,
+		SellerId:           listing.SellerId,
+		BuyerId:            req.BuyerId,
+		SourceInvestmentId: source.Id,
+		BuyerInvestmentId:  bought.Id,
+		Amount:             listing.Amount,
+		Price:              listing.Price,
+		Currency:           loan.Currency,
+		TransferredAt:      now(),
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.BuyerId), Valid: true},
+		},
+	}
+
+	// Keep the unsold remainder with the seller.
+	if remainder := source.Amount - listing.Amount; remainder > 0 {
+		kept := &model.LoanInvestment{
+			LoanId:     loan.Id,
+			InvestorId: listing.SellerId,
+			Amount:     remainder,
+			Currency:   loan.Currency,
+			CommonModel: model.CommonModel{
+				CreatedAt: now(),
+				CreatedBy: sql.NullInt64{Int64: int64(req.BuyerId), Valid: true},
+			},
+		}
+		err = s.repository.db.CreateLoanInvestment(ctx, kept, tx)
+		if err != nil {
+			util.LogContext(ctx).Error(err.Error())
+			return
+		}
+		transfer.RemainderInvestmentId = sql.NullInt64{Int64: int64(kept.Id), Valid: true}
+	}
+
+	// Close listing.
+	listing.Status = constant.InvestmentListingStatusSold
+	listing.ClosedAt = sql.NullTime{Time: now(), Valid: true}
+	listing.UpdatedBy = sql.NullInt64{Int64: int64(req.BuyerId), Valid: true}
+	err = s.repository.db.UpdateInvestmentListing(ctx, listing, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Record transfer.
+	err = s.repository.db.CreateInvestmentTransfer(ctx, transfer, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.BuyInvestmentListingResponse{
+		Transfer: transfer,
+	}
+
+	return
+}
+
+// ListInvestmentListings returns the listings of the secondary market, the open ones by default.
+func (s *service) ListInvestmentListings(ctx context.Context, req *model.ListInvestmentListingsRequest) (res *model.ListInvestmentListingsResponse, err error) {
+	// Use open listings if no status is requested.
+	status := constant.InvestmentListingStatus(req.Status)
+	if status == "" {
+		status = constant.InvestmentListingStatusOpen
+	}
+
+	// Get listings.
+	listings, err := s.repository.db.GetInvestmentListings(ctx, req.LoanId, status)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.ListInvestmentListingsResponse{
+		Listings: listings,
+	}
+
+	return
+}
+
+// ListInvestmentTransfers returns the transfer history of the secondary market, limited to the trades of the investor if set.
+func (s *service) ListInvestmentTransfers(ctx context.Context, req *model.ListInvestmentTransfersRequest) (res *model.ListInvestmentTransfersResponse, err error) {
+	// Get transfers.
+	transfers, err := s.repository.db.GetInvestmentTransfers(ctx, req.LoanId, req.InvestorId)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.ListInvestmentTransfersResponse{
+		Transfers: transfers,
+	}
+
+	return
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ffauzann/loan-service/internal/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidListingAmount(t *testing.T) {
+	currency := currencyLimits{minInvestment: 100 * money.Unit, maxInvestment: 10_000 * money.Unit}
+
+	tm := []struct {
+		name     string
+		position money.Amount
+		amount   money.Amount
+		want     bool
+	}{
+		{
+			name:     "wholePosition",
+			position: 1_000 * money.Unit,
+			amount:   1_000 * money.Unit,
+			want:     true,
+		},
+		{
+			name:     "partWithValidRemainder",
+			position: 1_000 * money.Unit,
+			amount:   900 * money.Unit,
+			want:     true,
+		},
+		{
+			name:     "exceedsPosition",
+			position: 1_000 * money.Unit,
+			amount:   1_001 * money.Unit,
+			want:     false,
+		},
+		{
+			name:     "belowMinInvestment",
+			position: 1_000 * money.Unit,
+			amount:   99 * money.Unit,
+			want:     false,
+		},
+		{
+			name:     "remainderBelowMinInvestment",
+			position: 1_000 * money.Unit,
+			amount:   950 * money.Unit,
+			want:     false,
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validListingAmount(tt.position, tt.amount, currency))
+		})
+	}
+}
@@ -31,9 +31,43 @@ type UserService interface {
 
 type LoanService interface {
 	CreateLoan(ctx context.Context, req *model.CreateLoanRequest) (res *model.CreateLoanResponse, err error)
+	GetLoan(ctx context.Context, req *model.GetLoanRequest) (res *model.GetLoanResponse, err error)
+	ListLoans(ctx context.Context, req *model.ListLoansRequest) (res *model.ListLoansResponse, err error)
 	ApproveLoan(ctx context.Context, req *model.ApproveLoanRequest) (res *model.ApproveLoanResponse, err error)
+	RejectLoan(ctx context.Context, req *model.RejectLoanRequest) (res *model.RejectLoanResponse, err error)
 	InvestInLoan(ctx context.Context, req *model.InvestInLoanRequest) (res *model.InvestInLoanResponse, err error)
+	ReserveInvestment(ctx context.Context, req *model.ReserveInvestmentRequest) (res *model.ReserveInvestmentResponse, err error)
+	ConfirmInvestment(ctx context.Context, req *model.ConfirmInvestmentRequest) (res *model.ConfirmInvestmentResponse, err error)
+	CancelInvestment(ctx context.Context, req *model.CancelInvestmentRequest) (res *model.CancelInvestmentResponse, err error)
+	CreateInvestmentListing(ctx context.Context, req *model.CreateInvestmentListingRequest) (res *model.CreateInvestmentListingResponse, err error)
+	CancelInvestmentListing(ctx context.Context, req *model.CancelInvestmentListingRequest) (res *model.CancelInvestmentListingResponse, err error)
+	BuyInvestmentListing(ctx context.Context, req *model.BuyInvestmentListingRequest) (res *model.BuyInvestmentListingResponse, err error)
+	ListInvestmentListings(ctx context.Context, req *model.ListInvestmentListingsRequest) (res *model.ListInvestmentListingsResponse, err error)
+	ListInvestmentTransfers(ctx context.Context, req *model.ListInvestmentTransfersRequest) (res *model.ListInvestmentTransfersResponse, err error)
+	ReleaseExpiredReservations(ctx context.Context) (res *model.ReleaseExpiredReservationsResponse, err error)
 	DisburseLoan(ctx context.Context, req *model.DisburseLoanRequest) (res *model.DisburseLoanResponse, err error)
+	CancelLoan(ctx context.Context, req *model.CancelLoanRequest) (res *model.CancelLoanResponse, err error)
+	ExpireLoans(ctx context.Context) (res *model.ExpireLoansResponse, err error)
+	GetRepaymentSchedule(ctx context.Context, req *model.GetRepaymentScheduleRequest) (res *model.GetRepaymentScheduleResponse, err error)
+	RecordRepayment(ctx context.Context, req *model.RecordRepaymentRequest) (res *model.RecordRepaymentResponse, err error)
+	TrackDelinquency(ctx context.Context) (res *model.TrackDelinquencyResponse, err error)
+	GetLoanDelinquency(ctx context.Context, req *model.GetLoanDelinquencyRequest) (res *model.GetLoanDelinquencyResponse, err error)
+	AccrueInterest(ctx context.Context) (res *model.AccrueInterestResponse, err error)
+	GetAccruedInterest(ctx context.Context, req *model.GetAccruedInterestRequest) (res *model.GetAccruedInterestResponse, err error)
+	WriteOffLoan(ctx context.Context, req *model.WriteOffLoanRequest) (res *model.WriteOffLoanResponse, err error)
+	PrepayLoan(ctx context.Context, req *model.PrepayLoanRequest) (res *model.PrepayLoanResponse, err error)
+	GetPayoffQuote(ctx context.Context, req *model.GetPayoffQuoteRequest) (res *model.GetPayoffQuoteResponse, err error)
+	RestructureLoan(ctx context.Context, req *model.RestructureLoanRequest) (res *model.RestructureLoanResponse, err error)
+	ReviewLoanRestructure(ctx context.Context, req *model.ReviewLoanRestructureRequest) (res *model.ReviewLoanRestructureResponse, err error)
+	GetBorrowerCreditLimit(ctx context.Context, req *model.GetBorrowerCreditLimitRequest) (res *model.GetBorrowerCreditLimitResponse, err error)
+	SetBorrowerCreditLimit(ctx context.Context, req *model.SetBorrowerCreditLimitRequest) (res *model.SetBorrowerCreditLimitResponse, err error)
+	CreateAutoInvestRule(ctx context.Context, req *model.CreateAutoInvestRuleRequest) (res *model.CreateAutoInvestRuleResponse, err error)
+	ListAutoInvestRules(ctx context.Context, req *model.ListAutoInvestRulesRequest) (res *model.ListAutoInvestRulesResponse, err error)
+	DeleteAutoInvestRule(ctx context.Context, req *model.DeleteAutoInvestRuleRequest) (res *model.DeleteAutoInvestRuleResponse, err error)
+	GetPortfolio(ctx context.Context, req *model.GetPortfolioRequest) (res *model.GetPortfolioResponse, err error)
+	AssignLoans(ctx context.Context) (res *model.AssignLoansResponse, err error)
+	ListMyAssignments(ctx context.Context, req *model.ListMyAssignmentsRequest) (res *model.ListMyAssignmentsResponse, err error)
+	ReassignLoan(ctx context.Context, req *model.ReassignLoanRequest) (res *model.ReassignLoanResponse, err error)
 }
 
 type NotificationService interface {
@@ -51,9 +85,10 @@ type repositoryWrapper struct {
 	redis        repository.RedisRepository
 	messaging    repository.MessagingRepository
 	notification repository.NotificationRepository
+	document     repository.DocumentRepository
 }
 
-func New(db repository.DBRepository, redis repository.RedisRepository, messaging repository.MessagingRepository, notif repository.NotificationRepository, config *model.AppConfig, logger *zap.Logger) Service {
+func New(db repository.DBRepository, redis repository.RedisRepository, messaging repository.MessagingRepository, notif repository.NotificationRepository, document repository.DocumentRepository, config *model.AppConfig, logger *zap.Logger) Service {
 	return &service{
 		config: config,
 		logger: logger,
@@ -62,6 +97,7 @@ func New(db repository.DBRepository, redis repository.RedisRepository, messaging
 			redis:        redis,
 			messaging:    messaging,
 			notification: notif,
+			document:     document,
 		},
 	}
 }
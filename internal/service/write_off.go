@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/statemachine"
+	"github.com/ffauzann/loan-service/internal/util"
+)
+
+// WriteOffLoan recognizes a non-performing loan as bad debt.
+// The outstanding principal is recorded as a loss and spread across investors pro-rata to their investments.
+// The schedule is kept so later recovery payments can still be recorded and distributed.
+func (s *service) WriteOffLoan(ctx context.Context, req *model.WriteOffLoanRequest) (res *model.WriteOffLoanResponse, err error) {
+	// Begin tx.
+	tx, err := s.repository.db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+	defer func() { s.repository.db.EndTx(ctx, tx, err) }()
+
+	// Get loan by ID.
+	loan, err := s.repository.db.GetLoanById(ctx, req.LoanId, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Validate loan state.
+	if err = statemachine.Loan.Can(loan, statemachine.EventWriteOff, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+
+	// Get installments.
+	installments, err := s.repository.db.GetInstallmentsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Prepare write-off model.
+	writeOff := &model.LoanWriteOff{
+		LoanId:       loan.Id,
+		Reason:       req.Reason,
+		WriteOffDate: now(),
+		CommonModel: model.CommonModel{
+			CreatedAt: now(),
+			CreatedBy: sql.NullInt64{Int64: int64(req.AdminId), Valid: true},
+			UpdatedAt: sql.NullTime{Time: now(), Valid: true},
+			UpdatedBy: sql.NullInt64{Int64: int64(req.AdminId), Valid: true},
+		},
+	}
+	for _, installment := range installments {
+		writeOff.OutstandingPrincipal += installment.PrincipalAmount - installment.PaidPrincipal
+		writeOff.OutstandingInterest += installment.InterestAmount - installment.PaidInterest
+		writeOff.OutstandingFee += installment.FeeAmount - installment.PaidFee
+	}
+
+	// Save write-off record.
+	err = s.repository.db.CreateLoanWriteOff(ctx, writeOff, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Get active investments of the loan.
+	investments, err := s.repository.db.GetInvestmentsByLoanId(ctx, loan.Id, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Spread the realized loss across investors pro-rata.
+	losses := buildInvestorLosses(writeOff, investments)
+	err = s.repository.db.CreateInvestorLosses(ctx, losses, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Update loan state to written off.
+	previous := loan.State
+	if err = statemachine.Loan.Fire(loan, statemachine.EventWriteOff, req.RoleId); err != nil {
+		util.LogContext(ctx).Warn(err.Error())
+		return
+	}
+	setAccrualEndDate(loan, previous, writeOff.WriteOffDate)
+	loan.UpdatedBy = sql.NullInt64{Int64: int64(req.AdminId), Valid: true}
+	err = s.repository.db.UpdateLoan(ctx, loan, tx)
+	if err != nil {
+		util.LogContext(ctx).Error(err.Error())
+		return
+	}
+
+	// Construct response.
+	res = &model.WriteOffLoanResponse{
+		LoanId:               loan.Id,
+		Currency:             loan.Currency,
+		State:                string(loan.State),
+		OutstandingPrincipal: writeOff.OutstandingPrincipal,
+		OutstandingInterest:  writeOff.OutstandingInterest,
+		OutstandingFee:       writeOff.OutstandingFee,
+		LossAmount:           writeOff.OutstandingPrincipal,
+		WriteOffDate:         writeOff.WriteOffDate,
+	}
+	investors := make(map[uint64]struct{})
+	for _, loss := range losses {
+		investors[loss.InvestorId] = struct{}{}
+	}
+	res.AffectedInvestorCount = uint32(len(investors))
+
+	return
+}
+
+// buildInvestorLosses spreads the outstanding principal of a write-off across the active investments of a loan.
+func buildInvestorLosses(writeOff *model.LoanWriteOff, investments []*model.LoanInvestment) []*model.InvestorLoss {
+	shares := splitProRata(writeOff.OutstandingPrincipal, investments)
+
+	losses := make([]*model.InvestorLoss, 0, len(investments))
+	for i, investment := range investments {
+		if shares[i] == 0 {
+			continue
+		}
+
+		losses = append(losses, &model.InvestorLoss{
+			LoanId:       writeOff.LoanId,
+			WriteOffId:   writeOff.Id,
+			InvestmentId: investment.Id,
+			InvestorId:   investment.InvestorId,
+			LossAmount:   shares[i],
+			CommonModel: model.CommonModel{
+				CreatedAt: now(),
+				CreatedBy: writeOff.CreatedBy,
+			},
+		})
+	}
+
+	return losses
+}
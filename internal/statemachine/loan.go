@@ -0,0 +1,357 @@
+// Package statemachine declares the loan lifecycle: every loan state, the events moving a loan between states,
+// the roles allowed to trigger each event and the guards that must hold before it fires.
+package statemachine
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+)
+
+// Event is an action that moves a loan from one state to another.
+type Event string
+
+// Known loan events.
+const (
+	EventApprove       Event = "APPROVE"
+	EventReject        Event = "REJECT"
+	EventInvest        Event = "INVEST"         // Investment that leaves the loan partially funded.
+	EventFullyInvest   Event = "FULLY_INVEST"   // Investment that completes the funding.
+	EventWithdraw      Event = "WITHDRAW"       // Investment cancellation leaving other investments in the loan.
+	EventFullyWithdraw Event = "FULLY_WITHDRAW" // Investment cancellation removing the last investment of the loan.
+	EventCancel        Event = "CANCEL"
+	EventExpire        Event = "EXPIRE"
+	EventDisburse      Event = "DISBURSE"
+	EventRepay         Event = "REPAY"
+	EventClose         Event = "CLOSE" // Repayment settling the last outstanding amount.
+	EventPrepay        Event = "PREPAY"
+	EventPayOff        Event = "PAY_OFF"
+	EventDefault       Event = "DEFAULT"
+	EventWriteOff      Event = "WRITE_OFF"
+	EventRestructure   Event = "RESTRUCTURE"
+)
+
+// Guard reports why a transition must not fire for the given loan, or nil if it may.
+type Guard func(loan *model.Loan) error
+
+// Transition declares the loan states an event may fire from, the state each one leads to,
+// the roles allowed to trigger it and the guards that must hold.
+type Transition struct {
+	Event   Event
+	Targets map[constant.LoanState]constant.LoanState // Source state to target state, a self-loop keeps the state.
+	Roles   []uint8
+	Guards  []Guard
+}
+
+// TransitionError is returned for every event that may not fire from the current loan state.
+// It matches constant.ErrIllegalLoanTransition with errors.Is.
+type TransitionError struct {
+	Event  Event
+	State  constant.LoanState
+	Reason string // Failed guard, empty if the event is not allowed from State at all.
+}
+
+func (e *TransitionError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("%s: %s is not allowed on a %s loan, %s", constant.ErrIllegalLoanTransition.Error(), e.Event, e.State, e.Reason)
+	}
+	return fmt.Sprintf("%s: %s is not allowed on a %s loan", constant.ErrIllegalLoanTransition.Error(), e.Event, e.State)
+}
+
+func (e *TransitionError) Is(target error) bool {
+	return target == constant.ErrIllegalLoanTransition
+}
+
+// Machine validates and applies loan state transitions.
+type Machine struct {
+	states      []constant.LoanState
+	transitions map[Event]Transition
+}
+
+// New builds a machine over the given states. It panics if a transition refers to an undeclared state
+// or if an event is declared twice, both being programming errors.
+func New(states []constant.LoanState, transitions ...Transition) *Machine {
+	m := &Machine{
+		states:      states,
+		transitions: make(map[Event]Transition, len(transitions)),
+	}
+	for _, t := range transitions {
+		if _, ok := m.transitions[t.Event]; ok {
+			panic(fmt.Sprintf("statemachine: duplicate event %s", t.Event))
+		}
+		for from, to := range t.Targets {
+			if !slices.Contains(states, from) || !slices.Contains(states, to) {
+				panic(fmt.Sprintf("statemachine: event %s refers to an undeclared state", t.Event))
+			}
+		}
+		m.transitions[t.Event] = t
+	}
+
+	return m
+}
+
+// States returns every declared state.
+func (m *Machine) States() []constant.LoanState {
+	return slices.Clone(m.states)
+}
+
+// Sources returns the states the event may fire from, in declaration order.
+func (m *Machine) Sources(event Event) (states []constant.LoanState) {
+	t := m.transitions[event]
+	for _, state := range m.states {
+		if _, ok := t.Targets[state]; ok {
+			states = append(states, state)
+		}
+	}
+	return
+}
+
+// Can reports whether roleId may fire the event on the loan without changing it.
+func (m *Machine) Can(loan *model.Loan, event Event, roleId uint8) (err error) {
+	_, err = m.target(loan, event, roleId)
+	return
+}
+
+// Fire validates the event like Can and moves the loan to its target state.
+func (m *Machine) Fire(loan *model.Loan, event Event, roleId uint8) (err error) {
+	to, err := m.target(loan, event, roleId)
+	if err != nil {
+		return
+	}
+
+	loan.State = to
+	return
+}
+
+func (m *Machine) target(loan *model.Loan, event Event, roleId uint8) (to constant.LoanState, err error) {
+	t, ok := m.transitions[event]
+	if !ok {
+		return "", &TransitionError{Event: event, State: loan.State}
+	}
+
+	to, ok = t.Targets[loan.State]
+	if !ok {
+		return "", &TransitionError{Event: event, State: loan.State}
+	}
+
+	if !slices.Contains(t.Roles, roleId) {
+		return "", constant.ErrPermissionDenied
+	}
+
+	for _, guard := range t.Guards {
+		if err = guard(loan); err != nil {
+			return "", &TransitionError{Event: event, State: loan.State, Reason: err.Error()}
+		}
+	}
+
+	return
+}
+
+// Guard reasons.
+var (
+	errNotFullyInvested = errors.New("loan is not fully invested")
+	errFullyInvested    = errors.New("loan is already fully invested")
+	errNotPastDefault   = errors.New("loan is not past due long enough to default")
+	errNotInvested      = errors.New("loan has no investment left")
+	errInvested         = errors.New("loan still has investments")
+)
+
+// isFullyInvested holds once investments cover the whole principal.
+func isFullyInvested(loan *model.Loan) error {
+	if loan.InvestedAmount != loan.PrincipalAmount {
+		return errNotFullyInvested
+	}
+	return nil
+}
+
+// isPartiallyInvested holds while investments do not cover the whole principal yet.
+func isPartiallyInvested(loan *model.Loan) error {
+	if loan.InvestedAmount >= loan.PrincipalAmount {
+		return errFullyInvested
+	}
+	return nil
+}
+
+// isInvested holds while some investment is left in the loan.
+func isInvested(loan *model.Loan) error {
+	if loan.InvestedAmount <= 0 {
+		return errNotInvested
+	}
+	return nil
+}
+
+// isUninvested holds once no investment is left in the loan.
+func isUninvested(loan *model.Loan) error {
+	if loan.InvestedAmount != 0 {
+		return errInvested
+	}
+	return nil
+}
+
+// isPastDefault holds once the loan is past due longer than constant.LoanDefaultDaysPastDue.
+func isPastDefault(loan *model.Loan) error {
+	if loan.DaysPastDue <= constant.LoanDefaultDaysPastDue {
+		return errNotPastDefault
+	}
+	return nil
+}
+
+// systemRoles trigger the transitions run by background jobs.
+var systemRoles = []uint8{constant.RoleIdSystem}
+
+// Loan is the lifecycle every loan follows.
+var Loan = New(
+	[]constant.LoanState{
+		constant.LoanStateProposed,
+		constant.LoanStateApproved,
+		constant.LoanStateRejected,
+		constant.LoanStateCancelled,
+		constant.LoanStateExpired,
+		constant.LoanStateFunding,
+		constant.LoanStateInvested,
+		constant.LoanStateDisbursed,
+		constant.LoanStateRepaying,
+		constant.LoanStateClosed,
+		constant.LoanStateDefaulted,
+		constant.LoanStateWrittenOff,
+	},
+	Transition{
+		Event: EventApprove,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateProposed: constant.LoanStateApproved,
+		},
+		Roles: constant.AllowedRolesApproveLoan,
+	},
+	Transition{
+		Event: EventReject,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateProposed: constant.LoanStateRejected,
+		},
+		Roles: constant.AllowedRolesApproveLoan,
+	},
+	Transition{
+		Event: EventInvest,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateApproved: constant.LoanStateFunding,
+			constant.LoanStateFunding:  constant.LoanStateFunding,
+		},
+		Roles:  constant.AllowedRolesInvestLoan,
+		Guards: []Guard{isPartiallyInvested},
+	},
+	Transition{
+		Event: EventFullyInvest,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateApproved: constant.LoanStateInvested,
+			constant.LoanStateFunding:  constant.LoanStateInvested,
+		},
+		Roles:  constant.AllowedRolesInvestLoan,
+		Guards: []Guard{isFullyInvested},
+	},
+	Transition{
+		Event: EventWithdraw,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateFunding: constant.LoanStateFunding,
+		},
+		Roles:  constant.AllowedRolesCancelInvestment,
+		Guards: []Guard{isInvested},
+	},
+	Transition{
+		Event: EventFullyWithdraw,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateFunding: constant.LoanStateApproved,
+		},
+		Roles:  constant.AllowedRolesCancelInvestment,
+		Guards: []Guard{isUninvested},
+	},
+	Transition{
+		Event: EventCancel,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateProposed: constant.LoanStateCancelled,
+			constant.LoanStateApproved: constant.LoanStateCancelled,
+			constant.LoanStateFunding:  constant.LoanStateCancelled,
+		},
+		Roles: constant.AllowedRolesCancelLoan,
+	},
+	Transition{
+		Event: EventExpire,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateApproved: constant.LoanStateExpired,
+			constant.LoanStateFunding:  constant.LoanStateExpired,
+		},
+		Roles: systemRoles,
+	},
+	Transition{
+		Event: EventDisburse,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateInvested: constant.LoanStateDisbursed,
+		},
+		Roles:  constant.AllowedRolesDisburseLoan,
+		Guards: []Guard{isFullyInvested},
+	},
+	Transition{
+		Event: EventRepay,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateDisbursed:  constant.LoanStateRepaying,
+			constant.LoanStateRepaying:   constant.LoanStateRepaying,
+			constant.LoanStateDefaulted:  constant.LoanStateDefaulted,  // Recovery payment.
+			constant.LoanStateWrittenOff: constant.LoanStateWrittenOff, // Recovery payment.
+		},
+		Roles: constant.AllowedRolesRecordRepayment,
+	},
+	Transition{
+		Event: EventClose,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateDisbursed: constant.LoanStateClosed,
+			constant.LoanStateRepaying:  constant.LoanStateClosed,
+			constant.LoanStateDefaulted: constant.LoanStateClosed,
+		},
+		Roles: constant.AllowedRolesRecordRepayment,
+	},
+	Transition{
+		Event: EventPrepay,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateDisbursed: constant.LoanStateRepaying,
+			constant.LoanStateRepaying:  constant.LoanStateRepaying,
+		},
+		Roles: constant.AllowedRolesPrepayLoan,
+	},
+	Transition{
+		Event: EventPayOff,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateDisbursed: constant.LoanStateClosed,
+			constant.LoanStateRepaying:  constant.LoanStateClosed,
+		},
+		Roles: constant.AllowedRolesPrepayLoan,
+	},
+	Transition{
+		Event: EventDefault,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateDisbursed: constant.LoanStateDefaulted,
+			constant.LoanStateRepaying:  constant.LoanStateDefaulted,
+			constant.LoanStateDefaulted: constant.LoanStateDefaulted,
+		},
+		Roles:  systemRoles,
+		Guards: []Guard{isPastDefault},
+	},
+	Transition{
+		Event: EventWriteOff,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateDisbursed: constant.LoanStateWrittenOff,
+			constant.LoanStateRepaying:  constant.LoanStateWrittenOff,
+			constant.LoanStateDefaulted: constant.LoanStateWrittenOff,
+		},
+		Roles: constant.AllowedRolesWriteOffLoan,
+	},
+	Transition{
+		Event: EventRestructure,
+		Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateDisbursed: constant.LoanStateDisbursed,
+			constant.LoanStateRepaying:  constant.LoanStateRepaying,
+			constant.LoanStateDefaulted: constant.LoanStateRepaying, // Arrears are capitalized.
+		},
+		Roles: constant.AllowedRolesRestructureLoan,
+	},
+)
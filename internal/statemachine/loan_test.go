@@ -0,0 +1,161 @@
+package statemachine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ffauzann/loan-service/internal/constant"
+	"github.com/ffauzann/loan-service/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoanFire(t *testing.T) {
+	tm := []struct {
+		name      string
+		loan      *model.Loan
+		event     Event
+		roleId    uint8
+		wantState constant.LoanState
+		wantErr   error
+	}{
+		{
+			name:      "approve",
+			loan:      &model.Loan{State: constant.LoanStateProposed},
+			event:     EventApprove,
+			roleId:    constant.RoleIdFieldValidator,
+			wantState: constant.LoanStateApproved,
+		},
+		{
+			name:    "disburseFunding",
+			loan:    &model.Loan{State: constant.LoanStateFunding},
+			event:   EventDisburse,
+			roleId:  constant.RoleIdAdmin,
+			wantErr: constant.ErrIllegalLoanTransition,
+		},
+		{
+			name:    "approveAsInvestor",
+			loan:    &model.Loan{State: constant.LoanStateProposed},
+			event:   EventApprove,
+			roleId:  constant.RoleIdInvestor,
+			wantErr: constant.ErrPermissionDenied,
+		},
+		{
+			name:      "partialInvestment",
+			loan:      &model.Loan{State: constant.LoanStateApproved, PrincipalAmount: 10_000, InvestedAmount: 4_000},
+			event:     EventInvest,
+			roleId:    constant.RoleIdInvestor,
+			wantState: constant.LoanStateFunding,
+		},
+		{
+			name:    "fullInvestmentGuard",
+			loan:    &model.Loan{State: constant.LoanStateFunding, PrincipalAmount: 10_000, InvestedAmount: 4_000},
+			event:   EventFullyInvest,
+			roleId:  constant.RoleIdInvestor,
+			wantErr: constant.ErrIllegalLoanTransition,
+		},
+		{
+			name:      "withdrawLastInvestment",
+			loan:      &model.Loan{State: constant.LoanStateFunding, PrincipalAmount: 10_000},
+			event:     EventFullyWithdraw,
+			roleId:    constant.RoleIdInvestor,
+			wantState: constant.LoanStateApproved,
+		},
+		{
+			name:    "withdrawInvestedLoan",
+			loan:    &model.Loan{State: constant.LoanStateInvested, PrincipalAmount: 10_000, InvestedAmount: 6_000},
+			event:   EventWithdraw,
+			roleId:  constant.RoleIdInvestor,
+			wantErr: constant.ErrIllegalLoanTransition,
+		},
+		{
+			name:    "withdrawGuard",
+			loan:    &model.Loan{State: constant.LoanStateFunding, PrincipalAmount: 10_000},
+			event:   EventWithdraw,
+			roleId:  constant.RoleIdInvestor,
+			wantErr: constant.ErrIllegalLoanTransition,
+		},
+		{
+			name:      "recoveryKeepsWrittenOff",
+			loan:      &model.Loan{State: constant.LoanStateWrittenOff},
+			event:     EventRepay,
+			roleId:    constant.RoleIdAdmin,
+			wantState: constant.LoanStateWrittenOff,
+		},
+		{
+			name:      "defaultBySystem",
+			loan:      &model.Loan{State: constant.LoanStateRepaying, DaysPastDue: constant.LoanDefaultDaysPastDue + 1},
+			event:     EventDefault,
+			roleId:    constant.RoleIdSystem,
+			wantState: constant.LoanStateDefaulted,
+		},
+		{
+			name:    "defaultByAdmin",
+			loan:    &model.Loan{State: constant.LoanStateRepaying, DaysPastDue: constant.LoanDefaultDaysPastDue + 1},
+			event:   EventDefault,
+			roleId:  constant.RoleIdAdmin,
+			wantErr: constant.ErrPermissionDenied,
+		},
+		{
+			name:      "restructureDefaulted",
+			loan:      &model.Loan{State: constant.LoanStateDefaulted},
+			event:     EventRestructure,
+			roleId:    constant.RoleIdAdmin,
+			wantState: constant.LoanStateRepaying,
+		},
+		{
+			name:    "closedIsTerminal",
+			loan:    &model.Loan{State: constant.LoanStateClosed},
+			event:   EventRepay,
+			roleId:  constant.RoleIdAdmin,
+			wantErr: constant.ErrIllegalLoanTransition,
+		},
+	}
+
+	for _, tt := range tm {
+		tt := tt // Prevent race condition.
+		t.Run(tt.name, func(t *testing.T) {
+			from := tt.loan.State
+			err := Loan.Fire(tt.loan, tt.event, tt.roleId)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, from, tt.loan.State)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantState, tt.loan.State)
+		})
+	}
+}
+
+func TestTransitionError(t *testing.T) {
+	err := Loan.Can(&model.Loan{State: constant.LoanStateFunding}, EventDisburse, constant.RoleIdAdmin)
+
+	var transitionErr *TransitionError
+	assert.True(t, errors.As(err, &transitionErr))
+	assert.Equal(t, EventDisburse, transitionErr.Event)
+	assert.Equal(t, constant.LoanStateFunding, transitionErr.State)
+	assert.Equal(t, "Illegal loan state transition: DISBURSE is not allowed on a FUNDING loan", err.Error())
+}
+
+func TestLoanSources(t *testing.T) {
+	assert.Equal(t, []constant.LoanState{
+		constant.LoanStateDisbursed,
+		constant.LoanStateRepaying,
+		constant.LoanStateDefaulted,
+		constant.LoanStateWrittenOff,
+	}, Loan.Sources(EventRepay))
+	assert.Empty(t, Loan.Sources(Event("UNKNOWN")))
+}
+
+func TestNewPanics(t *testing.T) {
+	states := []constant.LoanState{constant.LoanStateProposed}
+	assert.Panics(t, func() {
+		New(states, Transition{Event: EventApprove, Targets: map[constant.LoanState]constant.LoanState{
+			constant.LoanStateProposed: constant.LoanStateApproved,
+		}})
+	})
+	assert.Panics(t, func() {
+		New(states, Transition{Event: EventApprove}, Transition{Event: EventApprove})
+	})
+}
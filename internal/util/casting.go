@@ -17,6 +17,18 @@ func CastStruct[T any](i any) *T {
 	return v
 }
 
+// DecodeStruct casts like CastStruct but returns the error of fields rejecting their value, such as malformed money amounts.
+func DecodeStruct[T any](i any) (v *T, err error) {
+	b, err := json.Marshal(i)
+	if err != nil {
+		return
+	}
+
+	v = new(T)
+	err = json.Unmarshal(b, v)
+	return
+}
+
 func CastStructToMap(s any) (m map[string]interface{}) {
 	b, _ := json.Marshal(s)
 	_ = json.Unmarshal(b, &m)
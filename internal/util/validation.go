@@ -8,6 +8,7 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/ffauzann/loan-service/internal/money"
 	goValidator "github.com/go-playground/validator/v10"
 )
 
@@ -17,6 +18,7 @@ func SetValidator() {
 	validator = goValidator.New()
 	registerValidatorTag()
 	registerCustomValidator()
+	registerCustomType()
 }
 
 // validateStruct validates and return readable error if its not nil.
@@ -97,3 +99,10 @@ func registerCustomValidator() {
 		return
 	}
 }
+
+// registerCustomType validates money amounts by their value in currency units, so tags like gte=1000 read naturally.
+func registerCustomType() {
+	validator.RegisterCustomTypeFunc(func(v reflect.Value) any {
+		return v.Interface().(money.Amount).Float64()
+	}, money.Amount(0))
+}
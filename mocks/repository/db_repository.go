@@ -10,10 +10,13 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/ffauzann/loan-service/internal/model"
+	"github.com/ffauzann/loan-service/internal/money"
 
 	sql "database/sql"
 
 	sqlx "github.com/jmoiron/sqlx"
+
+	time "time"
 )
 
 // DBRepository is an autogenerated mock type for the DBRepository type
@@ -21,6 +24,20 @@ type DBRepository struct {
 	mock.Mock
 }
 
+// AddLoanWriteOffRecovery provides a mock function with given fields: ctx, loanId, amount, tx
+func (_m *DBRepository) AddLoanWriteOffRecovery(ctx context.Context, loanId uint64, amount money.Amount, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, loanId, amount, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, money.Amount, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, loanId, amount, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ApproveLoan provides a mock function with given fields: ctx, approval, tx
 func (_m *DBRepository) ApproveLoan(ctx context.Context, approval *model.LoanApproval, tx *sqlx.Tx) error {
 	ret := _m.Called(ctx, approval, tx)
@@ -35,6 +52,20 @@ func (_m *DBRepository) ApproveLoan(ctx context.Context, approval *model.LoanApp
 	return r0
 }
 
+// ArchiveLoanInstallments provides a mock function with given fields: ctx, loanId, restructureId, tx
+func (_m *DBRepository) ArchiveLoanInstallments(ctx context.Context, loanId uint64, restructureId uint64, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, loanId, restructureId, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, loanId, restructureId, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // BeginTx provides a mock function with given fields: ctx, opts
 func (_m *DBRepository) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error) {
 	ret := _m.Called(ctx, opts)
@@ -75,6 +106,90 @@ func (_m *DBRepository) CloseAccount(ctx context.Context, req *model.CloseAccoun
 	return r0
 }
 
+// CreateAutoInvestRule provides a mock function with given fields: ctx, rule, tx
+func (_m *DBRepository) CreateAutoInvestRule(ctx context.Context, rule *model.AutoInvestRule, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, rule, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AutoInvestRule, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, rule, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateBorrowerCreditLimitAudit provides a mock function with given fields: ctx, audit, tx
+func (_m *DBRepository) CreateBorrowerCreditLimitAudit(ctx context.Context, audit *model.BorrowerCreditLimitAudit, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, audit, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.BorrowerCreditLimitAudit, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, audit, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateInvestmentListing provides a mock function with given fields: ctx, listing, tx
+func (_m *DBRepository) CreateInvestmentListing(ctx context.Context, listing *model.InvestmentListing, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, listing, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.InvestmentListing, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, listing, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateInvestmentTransfer provides a mock function with given fields: ctx, transfer, tx
+func (_m *DBRepository) CreateInvestmentTransfer(ctx context.Context, transfer *model.InvestmentTransfer, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, transfer, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.InvestmentTransfer, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, transfer, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateInvestorLosses provides a mock function with given fields: ctx, losses, tx
+func (_m *DBRepository) CreateInvestorLosses(ctx context.Context, losses []*model.InvestorLoss, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, losses, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.InvestorLoss, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, losses, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateInvestorPayouts provides a mock function with given fields: ctx, payouts, tx
+func (_m *DBRepository) CreateInvestorPayouts(ctx context.Context, payouts []*model.InvestorPayout, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, payouts, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.InvestorPayout, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, payouts, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateLoan provides a mock function with given fields: ctx, loan, tx
 func (_m *DBRepository) CreateLoan(ctx context.Context, loan *model.Loan, tx *sqlx.Tx) error {
 	ret := _m.Called(ctx, loan, tx)
@@ -89,6 +204,48 @@ func (_m *DBRepository) CreateLoan(ctx context.Context, loan *model.Loan, tx *sq
 	return r0
 }
 
+// CreateLoanAgreement provides a mock function with given fields: ctx, agreement, tx
+func (_m *DBRepository) CreateLoanAgreement(ctx context.Context, agreement *model.LoanAgreement, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, agreement, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanAgreement, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, agreement, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateLoanAssignment provides a mock function with given fields: ctx, assignment, tx
+func (_m *DBRepository) CreateLoanAssignment(ctx context.Context, assignment *model.LoanAssignment, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, assignment, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanAssignment, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, assignment, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateLoanCancellation provides a mock function with given fields: ctx, cancellation, tx
+func (_m *DBRepository) CreateLoanCancellation(ctx context.Context, cancellation *model.LoanCancellation, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, cancellation, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanCancellation, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, cancellation, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateLoanDisbursement provides a mock function with given fields: ctx, disbursement, tx
 func (_m *DBRepository) CreateLoanDisbursement(ctx context.Context, disbursement *model.LoanDisbursement, tx *sqlx.Tx) error {
 	ret := _m.Called(ctx, disbursement, tx)
@@ -103,6 +260,44 @@ func (_m *DBRepository) CreateLoanDisbursement(ctx context.Context, disbursement
 	return r0
 }
 
+// CreateLoanInstallments provides a mock function with given fields: ctx, installments, tx
+func (_m *DBRepository) CreateLoanInstallments(ctx context.Context, installments []*model.LoanInstallment, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, installments, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.LoanInstallment, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, installments, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateLoanInterestAccrual provides a mock function with given fields: ctx, accrual, tx
+func (_m *DBRepository) CreateLoanInterestAccrual(ctx context.Context, accrual *model.LoanInterestAccrual, tx *sqlx.Tx) (bool, error) {
+	ret := _m.Called(ctx, accrual, tx)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanInterestAccrual, *sqlx.Tx) (bool, error)); ok {
+		return rf(ctx, accrual, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanInterestAccrual, *sqlx.Tx) bool); ok {
+		r0 = rf(ctx, accrual, tx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.LoanInterestAccrual, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, accrual, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateLoanInvestment provides a mock function with given fields: ctx, investment, tx
 func (_m *DBRepository) CreateLoanInvestment(ctx context.Context, investment *model.LoanInvestment, tx *sqlx.Tx) error {
 	ret := _m.Called(ctx, investment, tx)
@@ -117,6 +312,76 @@ func (_m *DBRepository) CreateLoanInvestment(ctx context.Context, investment *mo
 	return r0
 }
 
+// CreateLoanInvestmentReservation provides a mock function with given fields: ctx, reservation, tx
+func (_m *DBRepository) CreateLoanInvestmentReservation(ctx context.Context, reservation *model.LoanInvestmentReservation, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, reservation, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanInvestmentReservation, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, reservation, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateLoanRejection provides a mock function with given fields: ctx, rejection, tx
+func (_m *DBRepository) CreateLoanRejection(ctx context.Context, rejection *model.LoanRejection, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, rejection, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanRejection, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, rejection, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateLoanRepayment provides a mock function with given fields: ctx, repayment, tx
+func (_m *DBRepository) CreateLoanRepayment(ctx context.Context, repayment *model.LoanRepayment, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, repayment, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanRepayment, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, repayment, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateLoanRestructure provides a mock function with given fields: ctx, restructure, tx
+func (_m *DBRepository) CreateLoanRestructure(ctx context.Context, restructure *model.LoanRestructure, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, restructure, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanRestructure, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, restructure, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateLoanWriteOff provides a mock function with given fields: ctx, writeOff, tx
+func (_m *DBRepository) CreateLoanWriteOff(ctx context.Context, writeOff *model.LoanWriteOff, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, writeOff, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanWriteOff, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, writeOff, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateUser provides a mock function with given fields: ctx, user, tx
 func (_m *DBRepository) CreateUser(ctx context.Context, user *model.User, tx *sqlx.Tx) error {
 	ret := _m.Called(ctx, user, tx)
@@ -131,30 +396,58 @@ func (_m *DBRepository) CreateUser(ctx context.Context, user *model.User, tx *sq
 	return r0
 }
 
+// DeleteAutoInvestRule provides a mock function with given fields: ctx, rule, tx
+func (_m *DBRepository) DeleteAutoInvestRule(ctx context.Context, rule *model.AutoInvestRule, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, rule, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AutoInvestRule, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, rule, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteLoanInstallments provides a mock function with given fields: ctx, installmentIds, tx
+func (_m *DBRepository) DeleteLoanInstallments(ctx context.Context, installmentIds []uint64, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, installmentIds, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, installmentIds, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // EndTx provides a mock function with given fields: ctx, tx, err
 func (_m *DBRepository) EndTx(ctx context.Context, tx *sqlx.Tx, err error) {
 	_m.Called(ctx, tx, err)
 }
 
-// GetInvestmentsByLoanId provides a mock function with given fields: ctx, loanId, tx
-func (_m *DBRepository) GetInvestmentsByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) ([]*model.LoanInvestment, error) {
-	ret := _m.Called(ctx, loanId, tx)
+// ExpireLoanInvestmentReservations provides a mock function with given fields: ctx, at
+func (_m *DBRepository) ExpireLoanInvestmentReservations(ctx context.Context, at time.Time) ([]uint64, error) {
+	ret := _m.Called(ctx, at)
 
-	var r0 []*model.LoanInvestment
+	var r0 []uint64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) ([]*model.LoanInvestment, error)); ok {
-		return rf(ctx, loanId, tx)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]uint64, error)); ok {
+		return rf(ctx, at)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) []*model.LoanInvestment); ok {
-		r0 = rf(ctx, loanId, tx)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []uint64); ok {
+		r0 = rf(ctx, at)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.LoanInvestment)
+			r0 = ret.Get(0).([]uint64)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
-		r1 = rf(ctx, loanId, tx)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, at)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -162,25 +455,25 @@ func (_m *DBRepository) GetInvestmentsByLoanId(ctx context.Context, loanId uint6
 	return r0, r1
 }
 
-// GetLoanById provides a mock function with given fields: ctx, loanID, tx
-func (_m *DBRepository) GetLoanById(ctx context.Context, loanID uint64, tx *sqlx.Tx) (*model.Loan, error) {
-	ret := _m.Called(ctx, loanID, tx)
+// GetAccruingLoanIds provides a mock function with given fields: ctx, states
+func (_m *DBRepository) GetAccruingLoanIds(ctx context.Context, states []constant.LoanState) ([]uint64, error) {
+	ret := _m.Called(ctx, states)
 
-	var r0 *model.Loan
+	var r0 []uint64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) (*model.Loan, error)); ok {
-		return rf(ctx, loanID, tx)
+	if rf, ok := ret.Get(0).(func(context.Context, []constant.LoanState) ([]uint64, error)); ok {
+		return rf(ctx, states)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) *model.Loan); ok {
-		r0 = rf(ctx, loanID, tx)
+	if rf, ok := ret.Get(0).(func(context.Context, []constant.LoanState) []uint64); ok {
+		r0 = rf(ctx, states)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Loan)
+			r0 = ret.Get(0).([]uint64)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
-		r1 = rf(ctx, loanID, tx)
+	if rf, ok := ret.Get(1).(func(context.Context, []constant.LoanState) error); ok {
+		r1 = rf(ctx, states)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -188,25 +481,25 @@ func (_m *DBRepository) GetLoanById(ctx context.Context, loanID uint64, tx *sqlx
 	return r0, r1
 }
 
-// GetUserByIds provides a mock function with given fields: ctx, userIds, tx
-func (_m *DBRepository) GetUserByIds(ctx context.Context, userIds []uint64, tx *sqlx.Tx) ([]*model.User, error) {
-	ret := _m.Called(ctx, userIds, tx)
+// GetAutoInvestRuleById provides a mock function with given fields: ctx, ruleId, tx
+func (_m *DBRepository) GetAutoInvestRuleById(ctx context.Context, ruleId uint64, tx *sqlx.Tx) (*model.AutoInvestRule, error) {
+	ret := _m.Called(ctx, ruleId, tx)
 
-	var r0 []*model.User
+	var r0 *model.AutoInvestRule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []uint64, *sqlx.Tx) ([]*model.User, error)); ok {
-		return rf(ctx, userIds, tx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) (*model.AutoInvestRule, error)); ok {
+		return rf(ctx, ruleId, tx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []uint64, *sqlx.Tx) []*model.User); ok {
-		r0 = rf(ctx, userIds, tx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) *model.AutoInvestRule); ok {
+		r0 = rf(ctx, ruleId, tx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.User)
+			r0 = ret.Get(0).(*model.AutoInvestRule)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []uint64, *sqlx.Tx) error); ok {
-		r1 = rf(ctx, userIds, tx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, ruleId, tx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -214,25 +507,25 @@ func (_m *DBRepository) GetUserByIds(ctx context.Context, userIds []uint64, tx *
 	return r0, r1
 }
 
-// GetUserByOneOfIdentifier provides a mock function with given fields: ctx, val
-func (_m *DBRepository) GetUserByOneOfIdentifier(ctx context.Context, val string) (*model.User, error) {
-	ret := _m.Called(ctx, val)
+// GetAutoInvestRulesByCurrency provides a mock function with given fields: ctx, currency, tx
+func (_m *DBRepository) GetAutoInvestRulesByCurrency(ctx context.Context, currency string, tx *sqlx.Tx) ([]*model.AutoInvestRule, error) {
+	ret := _m.Called(ctx, currency, tx)
 
-	var r0 *model.User
+	var r0 []*model.AutoInvestRule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.User, error)); ok {
-		return rf(ctx, val)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *sqlx.Tx) ([]*model.AutoInvestRule, error)); ok {
+		return rf(ctx, currency, tx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *model.User); ok {
-		r0 = rf(ctx, val)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *sqlx.Tx) []*model.AutoInvestRule); ok {
+		r0 = rf(ctx, currency, tx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.User)
+			r0 = ret.Get(0).([]*model.AutoInvestRule)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, val)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, currency, tx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -240,23 +533,25 @@ func (_m *DBRepository) GetUserByOneOfIdentifier(ctx context.Context, val string
 	return r0, r1
 }
 
-// IsUserExist provides a mock function with given fields: ctx, userIdType, userIdVal
-func (_m *DBRepository) IsUserExist(ctx context.Context, userIdType constant.UserIdType, userIdVal string) (bool, error) {
-	ret := _m.Called(ctx, userIdType, userIdVal)
+// GetAutoInvestRulesByInvestorId provides a mock function with given fields: ctx, investorId, tx
+func (_m *DBRepository) GetAutoInvestRulesByInvestorId(ctx context.Context, investorId uint64, tx *sqlx.Tx) ([]*model.AutoInvestRule, error) {
+	ret := _m.Called(ctx, investorId, tx)
 
-	var r0 bool
+	var r0 []*model.AutoInvestRule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, constant.UserIdType, string) (bool, error)); ok {
-		return rf(ctx, userIdType, userIdVal)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) ([]*model.AutoInvestRule, error)); ok {
+		return rf(ctx, investorId, tx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, constant.UserIdType, string) bool); ok {
-		r0 = rf(ctx, userIdType, userIdVal)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) []*model.AutoInvestRule); ok {
+		r0 = rf(ctx, investorId, tx)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AutoInvestRule)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, constant.UserIdType, string) error); ok {
-		r1 = rf(ctx, userIdType, userIdVal)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, investorId, tx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -264,13 +559,993 @@ func (_m *DBRepository) IsUserExist(ctx context.Context, userIdType constant.Use
 	return r0, r1
 }
 
-// UpdateLoan provides a mock function with given fields: ctx, loan, tx
-func (_m *DBRepository) UpdateLoan(ctx context.Context, loan *model.Loan, tx *sqlx.Tx) error {
-	ret := _m.Called(ctx, loan, tx)
+// GetBorrowerCreditLimit provides a mock function with given fields: ctx, borrowerId, currency, tx
+func (_m *DBRepository) GetBorrowerCreditLimit(ctx context.Context, borrowerId uint64, currency string, tx *sqlx.Tx) (*model.BorrowerCreditLimit, error) {
+	ret := _m.Called(ctx, borrowerId, currency, tx)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Loan, *sqlx.Tx) error); ok {
-		r0 = rf(ctx, loan, tx)
+	var r0 *model.BorrowerCreditLimit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, *sqlx.Tx) (*model.BorrowerCreditLimit, error)); ok {
+		return rf(ctx, borrowerId, currency, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, *sqlx.Tx) *model.BorrowerCreditLimit); ok {
+		r0 = rf(ctx, borrowerId, currency, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BorrowerCreditLimit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, borrowerId, currency, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBorrowerExposure provides a mock function with given fields: ctx, borrowerId, currency, excludedStates, tx
+func (_m *DBRepository) GetBorrowerExposure(ctx context.Context, borrowerId uint64, currency string, excludedStates []constant.LoanState, tx *sqlx.Tx) (money.Amount, error) {
+	ret := _m.Called(ctx, borrowerId, currency, excludedStates, tx)
+
+	var r0 money.Amount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, []constant.LoanState, *sqlx.Tx) (money.Amount, error)); ok {
+		return rf(ctx, borrowerId, currency, excludedStates, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, []constant.LoanState, *sqlx.Tx) money.Amount); ok {
+		r0 = rf(ctx, borrowerId, currency, excludedStates, tx)
+	} else {
+		r0 = ret.Get(0).(money.Amount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, []constant.LoanState, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, borrowerId, currency, excludedStates, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInstallmentsByLoanId provides a mock function with given fields: ctx, loanId, tx
+func (_m *DBRepository) GetInstallmentsByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) ([]*model.LoanInstallment, error) {
+	ret := _m.Called(ctx, loanId, tx)
+
+	var r0 []*model.LoanInstallment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) ([]*model.LoanInstallment, error)); ok {
+		return rf(ctx, loanId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) []*model.LoanInstallment); ok {
+		r0 = rf(ctx, loanId, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LoanInstallment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, loanId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvestmentListingById provides a mock function with given fields: ctx, listingId, tx
+func (_m *DBRepository) GetInvestmentListingById(ctx context.Context, listingId uint64, tx *sqlx.Tx) (*model.InvestmentListing, error) {
+	ret := _m.Called(ctx, listingId, tx)
+
+	var r0 *model.InvestmentListing
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) (*model.InvestmentListing, error)); ok {
+		return rf(ctx, listingId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) *model.InvestmentListing); ok {
+		r0 = rf(ctx, listingId, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.InvestmentListing)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, listingId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvestmentListings provides a mock function with given fields: ctx, loanId, status
+func (_m *DBRepository) GetInvestmentListings(ctx context.Context, loanId uint64, status constant.InvestmentListingStatus) ([]*model.InvestmentListing, error) {
+	ret := _m.Called(ctx, loanId, status)
+
+	var r0 []*model.InvestmentListing
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, constant.InvestmentListingStatus) ([]*model.InvestmentListing, error)); ok {
+		return rf(ctx, loanId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, constant.InvestmentListingStatus) []*model.InvestmentListing); ok {
+		r0 = rf(ctx, loanId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.InvestmentListing)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, constant.InvestmentListingStatus) error); ok {
+		r1 = rf(ctx, loanId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvestmentTransfers provides a mock function with given fields: ctx, loanId, investorId
+func (_m *DBRepository) GetInvestmentTransfers(ctx context.Context, loanId uint64, investorId uint64) ([]*model.InvestmentTransfer, error) {
+	ret := _m.Called(ctx, loanId, investorId)
+
+	var r0 []*model.InvestmentTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) ([]*model.InvestmentTransfer, error)); ok {
+		return rf(ctx, loanId, investorId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) []*model.InvestmentTransfer); ok {
+		r0 = rf(ctx, loanId, investorId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.InvestmentTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, loanId, investorId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvestmentsByLoanId provides a mock function with given fields: ctx, loanId, tx
+func (_m *DBRepository) GetInvestmentsByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) ([]*model.LoanInvestment, error) {
+	ret := _m.Called(ctx, loanId, tx)
+
+	var r0 []*model.LoanInvestment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) ([]*model.LoanInvestment, error)); ok {
+		return rf(ctx, loanId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) []*model.LoanInvestment); ok {
+		r0 = rf(ctx, loanId, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LoanInvestment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, loanId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvestorBorrowerExposure provides a mock function with given fields: ctx, investorId, borrowerId, currency, excludedStates, tx
+func (_m *DBRepository) GetInvestorBorrowerExposure(ctx context.Context, investorId uint64, borrowerId uint64, currency string, excludedStates []constant.LoanState, tx *sqlx.Tx) (money.Amount, error) {
+	ret := _m.Called(ctx, investorId, borrowerId, currency, excludedStates, tx)
+
+	var r0 money.Amount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, string, []constant.LoanState, *sqlx.Tx) (money.Amount, error)); ok {
+		return rf(ctx, investorId, borrowerId, currency, excludedStates, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, string, []constant.LoanState, *sqlx.Tx) money.Amount); ok {
+		r0 = rf(ctx, investorId, borrowerId, currency, excludedStates, tx)
+	} else {
+		r0 = ret.Get(0).(money.Amount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, string, []constant.LoanState, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, investorId, borrowerId, currency, excludedStates, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvestorLoanHolding provides a mock function with given fields: ctx, loanId, investorId, tx
+func (_m *DBRepository) GetInvestorLoanHolding(ctx context.Context, loanId uint64, investorId uint64, tx *sqlx.Tx) (money.Amount, error) {
+	ret := _m.Called(ctx, loanId, investorId, tx)
+
+	var r0 money.Amount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, *sqlx.Tx) (money.Amount, error)); ok {
+		return rf(ctx, loanId, investorId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, *sqlx.Tx) money.Amount); ok {
+		r0 = rf(ctx, loanId, investorId, tx)
+	} else {
+		r0 = ret.Get(0).(money.Amount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, loanId, investorId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLastAssignedValidatorId provides a mock function with given fields: ctx, tx
+func (_m *DBRepository) GetLastAssignedValidatorId(ctx context.Context, tx *sqlx.Tx) (uint64, error) {
+	ret := _m.Called(ctx, tx)
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sqlx.Tx) (uint64, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sqlx.Tx) uint64); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoanApprovalsByLoanId provides a mock function with given fields: ctx, loanId, tx
+func (_m *DBRepository) GetLoanApprovalsByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) ([]*model.LoanApproval, error) {
+	ret := _m.Called(ctx, loanId, tx)
+
+	var r0 []*model.LoanApproval
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) ([]*model.LoanApproval, error)); ok {
+		return rf(ctx, loanId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) []*model.LoanApproval); ok {
+		r0 = rf(ctx, loanId, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LoanApproval)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, loanId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoanById provides a mock function with given fields: ctx, loanID, tx
+func (_m *DBRepository) GetLoanById(ctx context.Context, loanID uint64, tx *sqlx.Tx) (*model.Loan, error) {
+	ret := _m.Called(ctx, loanID, tx)
+
+	var r0 *model.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) (*model.Loan, error)); ok {
+		return rf(ctx, loanID, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) *model.Loan); ok {
+		r0 = rf(ctx, loanID, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Loan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, loanID, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoanDisbursementByLoanId provides a mock function with given fields: ctx, loanId, tx
+func (_m *DBRepository) GetLoanDisbursementByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) (*model.LoanDisbursement, error) {
+	ret := _m.Called(ctx, loanId, tx)
+
+	var r0 *model.LoanDisbursement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) (*model.LoanDisbursement, error)); ok {
+		return rf(ctx, loanId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) *model.LoanDisbursement); ok {
+		r0 = rf(ctx, loanId, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LoanDisbursement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, loanId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoanIdsByStates provides a mock function with given fields: ctx, states
+func (_m *DBRepository) GetLoanIdsByStates(ctx context.Context, states []constant.LoanState) ([]uint64, error) {
+	ret := _m.Called(ctx, states)
+
+	var r0 []uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []constant.LoanState) ([]uint64, error)); ok {
+		return rf(ctx, states)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []constant.LoanState) []uint64); ok {
+		r0 = rf(ctx, states)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []constant.LoanState) error); ok {
+		r1 = rf(ctx, states)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoanInterestAccrualSummary provides a mock function with given fields: ctx, loanId, tx
+func (_m *DBRepository) GetLoanInterestAccrualSummary(ctx context.Context, loanId uint64, tx *sqlx.Tx) (*model.LoanInterestAccrualSummary, error) {
+	ret := _m.Called(ctx, loanId, tx)
+
+	var r0 *model.LoanInterestAccrualSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) (*model.LoanInterestAccrualSummary, error)); ok {
+		return rf(ctx, loanId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) *model.LoanInterestAccrualSummary); ok {
+		r0 = rf(ctx, loanId, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LoanInterestAccrualSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, loanId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoanInvestmentById provides a mock function with given fields: ctx, investmentId, tx
+func (_m *DBRepository) GetLoanInvestmentById(ctx context.Context, investmentId uint64, tx *sqlx.Tx) (*model.LoanInvestment, error) {
+	ret := _m.Called(ctx, investmentId, tx)
+
+	var r0 *model.LoanInvestment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) (*model.LoanInvestment, error)); ok {
+		return rf(ctx, investmentId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) *model.LoanInvestment); ok {
+		r0 = rf(ctx, investmentId, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LoanInvestment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, investmentId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoanInvestmentReservationById provides a mock function with given fields: ctx, reservationId, tx
+func (_m *DBRepository) GetLoanInvestmentReservationById(ctx context.Context, reservationId uint64, tx *sqlx.Tx) (*model.LoanInvestmentReservation, error) {
+	ret := _m.Called(ctx, reservationId, tx)
+
+	var r0 *model.LoanInvestmentReservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) (*model.LoanInvestmentReservation, error)); ok {
+		return rf(ctx, reservationId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) *model.LoanInvestmentReservation); ok {
+		r0 = rf(ctx, reservationId, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LoanInvestmentReservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, reservationId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoanRepaymentsByLoanId provides a mock function with given fields: ctx, loanId, tx
+func (_m *DBRepository) GetLoanRepaymentsByLoanId(ctx context.Context, loanId uint64, tx *sqlx.Tx) ([]*model.LoanRepayment, error) {
+	ret := _m.Called(ctx, loanId, tx)
+
+	var r0 []*model.LoanRepayment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) ([]*model.LoanRepayment, error)); ok {
+		return rf(ctx, loanId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) []*model.LoanRepayment); ok {
+		r0 = rf(ctx, loanId, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LoanRepayment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, loanId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoanReservedAmount provides a mock function with given fields: ctx, loanId, investorId, at, tx
+func (_m *DBRepository) GetLoanReservedAmount(ctx context.Context, loanId uint64, investorId uint64, at time.Time, tx *sqlx.Tx) (money.Amount, error) {
+	ret := _m.Called(ctx, loanId, investorId, at, tx)
+
+	var r0 money.Amount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, time.Time, *sqlx.Tx) (money.Amount, error)); ok {
+		return rf(ctx, loanId, investorId, at, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, time.Time, *sqlx.Tx) money.Amount); ok {
+		r0 = rf(ctx, loanId, investorId, at, tx)
+	} else {
+		r0 = ret.Get(0).(money.Amount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, time.Time, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, loanId, investorId, at, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoanRestructureById provides a mock function with given fields: ctx, restructureId, tx
+func (_m *DBRepository) GetLoanRestructureById(ctx context.Context, restructureId uint64, tx *sqlx.Tx) (*model.LoanRestructure, error) {
+	ret := _m.Called(ctx, restructureId, tx)
+
+	var r0 *model.LoanRestructure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) (*model.LoanRestructure, error)); ok {
+		return rf(ctx, restructureId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) *model.LoanRestructure); ok {
+		r0 = rf(ctx, restructureId, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LoanRestructure)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, restructureId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoans provides a mock function with given fields: ctx, filter
+func (_m *DBRepository) GetLoans(ctx context.Context, filter *model.LoanFilter) ([]*model.Loan, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []*model.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanFilter) ([]*model.Loan, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanFilter) []*model.Loan); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Loan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.LoanFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOpenLoanAssignment provides a mock function with given fields: ctx, loanId, tx
+func (_m *DBRepository) GetOpenLoanAssignment(ctx context.Context, loanId uint64, tx *sqlx.Tx) (*model.LoanAssignment, error) {
+	ret := _m.Called(ctx, loanId, tx)
+
+	var r0 *model.LoanAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) (*model.LoanAssignment, error)); ok {
+		return rf(ctx, loanId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) *model.LoanAssignment); ok {
+		r0 = rf(ctx, loanId, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LoanAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, loanId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOverdueLoanIds provides a mock function with given fields: ctx, deadline
+func (_m *DBRepository) GetOverdueLoanIds(ctx context.Context, deadline time.Time) ([]uint64, error) {
+	ret := _m.Called(ctx, deadline)
+
+	var r0 []uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]uint64, error)); ok {
+		return rf(ctx, deadline)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []uint64); ok {
+		r0 = rf(ctx, deadline)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deadline)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPendingAssignments provides a mock function with given fields: ctx, validatorId, states
+func (_m *DBRepository) GetPendingAssignments(ctx context.Context, validatorId uint64, states []constant.LoanState) ([]*model.PendingAssignment, error) {
+	ret := _m.Called(ctx, validatorId, states)
+
+	var r0 []*model.PendingAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []constant.LoanState) ([]*model.PendingAssignment, error)); ok {
+		return rf(ctx, validatorId, states)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []constant.LoanState) []*model.PendingAssignment); ok {
+		r0 = rf(ctx, validatorId, states)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PendingAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, []constant.LoanState) error); ok {
+		r1 = rf(ctx, validatorId, states)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPortfolioHoldings provides a mock function with given fields: ctx, investorId, afterId, limit, tx
+func (_m *DBRepository) GetPortfolioHoldings(ctx context.Context, investorId uint64, afterId uint64, limit uint32, tx *sqlx.Tx) ([]*model.PortfolioHolding, error) {
+	ret := _m.Called(ctx, investorId, afterId, limit, tx)
+
+	var r0 []*model.PortfolioHolding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, uint32, *sqlx.Tx) ([]*model.PortfolioHolding, error)); ok {
+		return rf(ctx, investorId, afterId, limit, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, uint32, *sqlx.Tx) []*model.PortfolioHolding); ok {
+		r0 = rf(ctx, investorId, afterId, limit, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PortfolioHolding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, uint32, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, investorId, afterId, limit, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPortfolioStateSummaries provides a mock function with given fields: ctx, investorId, tx
+func (_m *DBRepository) GetPortfolioStateSummaries(ctx context.Context, investorId uint64, tx *sqlx.Tx) ([]*model.PortfolioStateSummary, error) {
+	ret := _m.Called(ctx, investorId, tx)
+
+	var r0 []*model.PortfolioStateSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) ([]*model.PortfolioStateSummary, error)); ok {
+		return rf(ctx, investorId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) []*model.PortfolioStateSummary); ok {
+		r0 = rf(ctx, investorId, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PortfolioStateSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, investorId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUnassignedLoans provides a mock function with given fields: ctx, states
+func (_m *DBRepository) GetUnassignedLoans(ctx context.Context, states []constant.LoanState) ([]*model.QueuedLoan, error) {
+	ret := _m.Called(ctx, states)
+
+	var r0 []*model.QueuedLoan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []constant.LoanState) ([]*model.QueuedLoan, error)); ok {
+		return rf(ctx, states)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []constant.LoanState) []*model.QueuedLoan); ok {
+		r0 = rf(ctx, states)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.QueuedLoan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []constant.LoanState) error); ok {
+		r1 = rf(ctx, states)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserByIds provides a mock function with given fields: ctx, userIds, tx
+func (_m *DBRepository) GetUserByIds(ctx context.Context, userIds []uint64, tx *sqlx.Tx) ([]*model.User, error) {
+	ret := _m.Called(ctx, userIds, tx)
+
+	var r0 []*model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64, *sqlx.Tx) ([]*model.User, error)); ok {
+		return rf(ctx, userIds, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64, *sqlx.Tx) []*model.User); ok {
+		r0 = rf(ctx, userIds, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, userIds, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserByOneOfIdentifier provides a mock function with given fields: ctx, val
+func (_m *DBRepository) GetUserByOneOfIdentifier(ctx context.Context, val string) (*model.User, error) {
+	ret := _m.Called(ctx, val)
+
+	var r0 *model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.User, error)); ok {
+		return rf(ctx, val)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.User); ok {
+		r0 = rf(ctx, val)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, val)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetValidatorWorkloads provides a mock function with given fields: ctx, states, tx
+func (_m *DBRepository) GetValidatorWorkloads(ctx context.Context, states []constant.LoanState, tx *sqlx.Tx) ([]*model.ValidatorWorkload, error) {
+	ret := _m.Called(ctx, states, tx)
+
+	var r0 []*model.ValidatorWorkload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []constant.LoanState, *sqlx.Tx) ([]*model.ValidatorWorkload, error)); ok {
+		return rf(ctx, states, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []constant.LoanState, *sqlx.Tx) []*model.ValidatorWorkload); ok {
+		r0 = rf(ctx, states, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ValidatorWorkload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []constant.LoanState, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, states, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasOpenInvestmentListing provides a mock function with given fields: ctx, investmentId, tx
+func (_m *DBRepository) HasOpenInvestmentListing(ctx context.Context, investmentId uint64, tx *sqlx.Tx) (bool, error) {
+	ret := _m.Called(ctx, investmentId, tx)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) (bool, error)); ok {
+		return rf(ctx, investmentId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) bool); ok {
+		r0 = rf(ctx, investmentId, tx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, investmentId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasPendingLoanRestructure provides a mock function with given fields: ctx, loanId, tx
+func (_m *DBRepository) HasPendingLoanRestructure(ctx context.Context, loanId uint64, tx *sqlx.Tx) (bool, error) {
+	ret := _m.Called(ctx, loanId, tx)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) (bool, error)); ok {
+		return rf(ctx, loanId, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *sqlx.Tx) bool); ok {
+		r0 = rf(ctx, loanId, tx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, loanId, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsUserExist provides a mock function with given fields: ctx, userIdType, userIdVal
+func (_m *DBRepository) IsUserExist(ctx context.Context, userIdType constant.UserIdType, userIdVal string) (bool, error) {
+	ret := _m.Called(ctx, userIdType, userIdVal)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, constant.UserIdType, string) (bool, error)); ok {
+		return rf(ctx, userIdType, userIdVal)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, constant.UserIdType, string) bool); ok {
+		r0 = rf(ctx, userIdType, userIdVal)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, constant.UserIdType, string) error); ok {
+		r1 = rf(ctx, userIdType, userIdVal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReverseLoanInvestments provides a mock function with given fields: ctx, loanId, status, tx
+func (_m *DBRepository) ReverseLoanInvestments(ctx context.Context, loanId uint64, status constant.LoanInvestmentStatus, tx *sqlx.Tx) ([]*model.LoanInvestment, error) {
+	ret := _m.Called(ctx, loanId, status, tx)
+
+	var r0 []*model.LoanInvestment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, constant.LoanInvestmentStatus, *sqlx.Tx) ([]*model.LoanInvestment, error)); ok {
+		return rf(ctx, loanId, status, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, constant.LoanInvestmentStatus, *sqlx.Tx) []*model.LoanInvestment); ok {
+		r0 = rf(ctx, loanId, status, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LoanInvestment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, constant.LoanInvestmentStatus, *sqlx.Tx) error); ok {
+		r1 = rf(ctx, loanId, status, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetAutoInvestRuleInvestedAt provides a mock function with given fields: ctx, ruleId, investedAt, tx
+func (_m *DBRepository) SetAutoInvestRuleInvestedAt(ctx context.Context, ruleId uint64, investedAt time.Time, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, ruleId, investedAt, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, ruleId, investedAt, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateInvestmentListing provides a mock function with given fields: ctx, listing, tx
+func (_m *DBRepository) UpdateInvestmentListing(ctx context.Context, listing *model.InvestmentListing, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, listing, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.InvestmentListing, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, listing, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLoan provides a mock function with given fields: ctx, loan, tx
+func (_m *DBRepository) UpdateLoan(ctx context.Context, loan *model.Loan, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, loan, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Loan, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, loan, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLoanAssignment provides a mock function with given fields: ctx, assignment, tx
+func (_m *DBRepository) UpdateLoanAssignment(ctx context.Context, assignment *model.LoanAssignment, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, assignment, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanAssignment, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, assignment, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLoanInstallment provides a mock function with given fields: ctx, installment, tx
+func (_m *DBRepository) UpdateLoanInstallment(ctx context.Context, installment *model.LoanInstallment, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, installment, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanInstallment, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, installment, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLoanInvestmentReservation provides a mock function with given fields: ctx, reservation, tx
+func (_m *DBRepository) UpdateLoanInvestmentReservation(ctx context.Context, reservation *model.LoanInvestmentReservation, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, reservation, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanInvestmentReservation, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, reservation, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLoanInvestmentStatus provides a mock function with given fields: ctx, investment, tx
+func (_m *DBRepository) UpdateLoanInvestmentStatus(ctx context.Context, investment *model.LoanInvestment, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, investment, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanInvestment, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, investment, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLoanRestructure provides a mock function with given fields: ctx, restructure, tx
+func (_m *DBRepository) UpdateLoanRestructure(ctx context.Context, restructure *model.LoanRestructure, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, restructure, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoanRestructure, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, restructure, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertBorrowerCreditLimit provides a mock function with given fields: ctx, limit, tx
+func (_m *DBRepository) UpsertBorrowerCreditLimit(ctx context.Context, limit *model.BorrowerCreditLimit, tx *sqlx.Tx) error {
+	ret := _m.Called(ctx, limit, tx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.BorrowerCreditLimit, *sqlx.Tx) error); ok {
+		r0 = rf(ctx, limit, tx)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -14,24 +14,674 @@ type Service struct {
 	mock.Mock
 }
 
+// AccrueInterest provides a mock function with given fields: ctx
+func (_m *Service) AccrueInterest(ctx context.Context) (*model.AccrueInterestResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *model.AccrueInterestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.AccrueInterestResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.AccrueInterestResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AccrueInterestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ApproveLoan provides a mock function with given fields: ctx, req
 func (_m *Service) ApproveLoan(ctx context.Context, req *model.ApproveLoanRequest) (*model.ApproveLoanResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *model.ApproveLoanResponse
+	var r0 *model.ApproveLoanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ApproveLoanRequest) (*model.ApproveLoanResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ApproveLoanRequest) *model.ApproveLoanResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApproveLoanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ApproveLoanRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssignLoans provides a mock function with given fields: ctx
+func (_m *Service) AssignLoans(ctx context.Context) (*model.AssignLoansResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *model.AssignLoansResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.AssignLoansResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.AssignLoansResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AssignLoansResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BuyInvestmentListing provides a mock function with given fields: ctx, req
+func (_m *Service) BuyInvestmentListing(ctx context.Context, req *model.BuyInvestmentListingRequest) (*model.BuyInvestmentListingResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.BuyInvestmentListingResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.BuyInvestmentListingRequest) (*model.BuyInvestmentListingResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.BuyInvestmentListingRequest) *model.BuyInvestmentListingResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BuyInvestmentListingResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.BuyInvestmentListingRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelInvestment provides a mock function with given fields: ctx, req
+func (_m *Service) CancelInvestment(ctx context.Context, req *model.CancelInvestmentRequest) (*model.CancelInvestmentResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.CancelInvestmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CancelInvestmentRequest) (*model.CancelInvestmentResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CancelInvestmentRequest) *model.CancelInvestmentResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CancelInvestmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CancelInvestmentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelInvestmentListing provides a mock function with given fields: ctx, req
+func (_m *Service) CancelInvestmentListing(ctx context.Context, req *model.CancelInvestmentListingRequest) (*model.CancelInvestmentListingResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.CancelInvestmentListingResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CancelInvestmentListingRequest) (*model.CancelInvestmentListingResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CancelInvestmentListingRequest) *model.CancelInvestmentListingResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CancelInvestmentListingResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CancelInvestmentListingRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelLoan provides a mock function with given fields: ctx, req
+func (_m *Service) CancelLoan(ctx context.Context, req *model.CancelLoanRequest) (*model.CancelLoanResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.CancelLoanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CancelLoanRequest) (*model.CancelLoanResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CancelLoanRequest) *model.CancelLoanResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CancelLoanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CancelLoanRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CloseAccount provides a mock function with given fields: ctx, req
+func (_m *Service) CloseAccount(ctx context.Context, req *model.CloseAccountRequest) (*model.CloseAccountResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.CloseAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CloseAccountRequest) (*model.CloseAccountResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CloseAccountRequest) *model.CloseAccountResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CloseAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CloseAccountRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfirmInvestment provides a mock function with given fields: ctx, req
+func (_m *Service) ConfirmInvestment(ctx context.Context, req *model.ConfirmInvestmentRequest) (*model.ConfirmInvestmentResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.ConfirmInvestmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ConfirmInvestmentRequest) (*model.ConfirmInvestmentResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ConfirmInvestmentRequest) *model.ConfirmInvestmentResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ConfirmInvestmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ConfirmInvestmentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAutoInvestRule provides a mock function with given fields: ctx, req
+func (_m *Service) CreateAutoInvestRule(ctx context.Context, req *model.CreateAutoInvestRuleRequest) (*model.CreateAutoInvestRuleResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.CreateAutoInvestRuleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateAutoInvestRuleRequest) (*model.CreateAutoInvestRuleResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateAutoInvestRuleRequest) *model.CreateAutoInvestRuleResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CreateAutoInvestRuleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateAutoInvestRuleRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateInvestmentListing provides a mock function with given fields: ctx, req
+func (_m *Service) CreateInvestmentListing(ctx context.Context, req *model.CreateInvestmentListingRequest) (*model.CreateInvestmentListingResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.CreateInvestmentListingResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateInvestmentListingRequest) (*model.CreateInvestmentListingResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateInvestmentListingRequest) *model.CreateInvestmentListingResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CreateInvestmentListingResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateInvestmentListingRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateLoan provides a mock function with given fields: ctx, req
+func (_m *Service) CreateLoan(ctx context.Context, req *model.CreateLoanRequest) (*model.CreateLoanResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.CreateLoanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateLoanRequest) (*model.CreateLoanResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateLoanRequest) *model.CreateLoanResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CreateLoanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateLoanRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAutoInvestRule provides a mock function with given fields: ctx, req
+func (_m *Service) DeleteAutoInvestRule(ctx context.Context, req *model.DeleteAutoInvestRuleRequest) (*model.DeleteAutoInvestRuleResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.DeleteAutoInvestRuleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteAutoInvestRuleRequest) (*model.DeleteAutoInvestRuleResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteAutoInvestRuleRequest) *model.DeleteAutoInvestRuleResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DeleteAutoInvestRuleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.DeleteAutoInvestRuleRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisburseLoan provides a mock function with given fields: ctx, req
+func (_m *Service) DisburseLoan(ctx context.Context, req *model.DisburseLoanRequest) (*model.DisburseLoanResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.DisburseLoanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DisburseLoanRequest) (*model.DisburseLoanResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DisburseLoanRequest) *model.DisburseLoanResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DisburseLoanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.DisburseLoanRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpireLoans provides a mock function with given fields: ctx
+func (_m *Service) ExpireLoans(ctx context.Context) (*model.ExpireLoansResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *model.ExpireLoansResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.ExpireLoansResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.ExpireLoansResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ExpireLoansResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccruedInterest provides a mock function with given fields: ctx, req
+func (_m *Service) GetAccruedInterest(ctx context.Context, req *model.GetAccruedInterestRequest) (*model.GetAccruedInterestResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.GetAccruedInterestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetAccruedInterestRequest) (*model.GetAccruedInterestResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetAccruedInterestRequest) *model.GetAccruedInterestResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.GetAccruedInterestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetAccruedInterestRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBorrowerCreditLimit provides a mock function with given fields: ctx, req
+func (_m *Service) GetBorrowerCreditLimit(ctx context.Context, req *model.GetBorrowerCreditLimitRequest) (*model.GetBorrowerCreditLimitResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.GetBorrowerCreditLimitResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetBorrowerCreditLimitRequest) (*model.GetBorrowerCreditLimitResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetBorrowerCreditLimitRequest) *model.GetBorrowerCreditLimitResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.GetBorrowerCreditLimitResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetBorrowerCreditLimitRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoan provides a mock function with given fields: ctx, req
+func (_m *Service) GetLoan(ctx context.Context, req *model.GetLoanRequest) (*model.GetLoanResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.GetLoanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetLoanRequest) (*model.GetLoanResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetLoanRequest) *model.GetLoanResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.GetLoanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetLoanRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLoanDelinquency provides a mock function with given fields: ctx, req
+func (_m *Service) GetLoanDelinquency(ctx context.Context, req *model.GetLoanDelinquencyRequest) (*model.GetLoanDelinquencyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.GetLoanDelinquencyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetLoanDelinquencyRequest) (*model.GetLoanDelinquencyResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetLoanDelinquencyRequest) *model.GetLoanDelinquencyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.GetLoanDelinquencyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetLoanDelinquencyRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPayoffQuote provides a mock function with given fields: ctx, req
+func (_m *Service) GetPayoffQuote(ctx context.Context, req *model.GetPayoffQuoteRequest) (*model.GetPayoffQuoteResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.GetPayoffQuoteResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetPayoffQuoteRequest) (*model.GetPayoffQuoteResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetPayoffQuoteRequest) *model.GetPayoffQuoteResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.GetPayoffQuoteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetPayoffQuoteRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPortfolio provides a mock function with given fields: ctx, req
+func (_m *Service) GetPortfolio(ctx context.Context, req *model.GetPortfolioRequest) (*model.GetPortfolioResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.GetPortfolioResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetPortfolioRequest) (*model.GetPortfolioResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetPortfolioRequest) *model.GetPortfolioResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.GetPortfolioResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetPortfolioRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRepaymentSchedule provides a mock function with given fields: ctx, req
+func (_m *Service) GetRepaymentSchedule(ctx context.Context, req *model.GetRepaymentScheduleRequest) (*model.GetRepaymentScheduleResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.GetRepaymentScheduleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetRepaymentScheduleRequest) (*model.GetRepaymentScheduleResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetRepaymentScheduleRequest) *model.GetRepaymentScheduleResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.GetRepaymentScheduleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetRepaymentScheduleRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvestInLoan provides a mock function with given fields: ctx, req
+func (_m *Service) InvestInLoan(ctx context.Context, req *model.InvestInLoanRequest) (*model.InvestInLoanResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.InvestInLoanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.InvestInLoanRequest) (*model.InvestInLoanResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.InvestInLoanRequest) *model.InvestInLoanResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.InvestInLoanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.InvestInLoanRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsUserExist provides a mock function with given fields: ctx, req
+func (_m *Service) IsUserExist(ctx context.Context, req *model.IsUserExistRequest) (*model.IsUserExistResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.IsUserExistResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.IsUserExistRequest) (*model.IsUserExistResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.IsUserExistRequest) *model.IsUserExistResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IsUserExistResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.IsUserExistRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Jwks provides a mock function with given fields: ctx
+func (_m *Service) Jwks(ctx context.Context) ([]*model.Jwk, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.Jwk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.Jwk, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Jwk); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Jwk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAutoInvestRules provides a mock function with given fields: ctx, req
+func (_m *Service) ListAutoInvestRules(ctx context.Context, req *model.ListAutoInvestRulesRequest) (*model.ListAutoInvestRulesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.ListAutoInvestRulesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.ApproveLoanRequest) (*model.ApproveLoanResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListAutoInvestRulesRequest) (*model.ListAutoInvestRulesResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.ApproveLoanRequest) *model.ApproveLoanResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListAutoInvestRulesRequest) *model.ListAutoInvestRulesResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.ApproveLoanResponse)
+			r0 = ret.Get(0).(*model.ListAutoInvestRulesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.ApproveLoanRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ListAutoInvestRulesRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -40,24 +690,24 @@ func (_m *Service) ApproveLoan(ctx context.Context, req *model.ApproveLoanReques
 	return r0, r1
 }
 
-// CloseAccount provides a mock function with given fields: ctx, req
-func (_m *Service) CloseAccount(ctx context.Context, req *model.CloseAccountRequest) (*model.CloseAccountResponse, error) {
+// ListInvestmentListings provides a mock function with given fields: ctx, req
+func (_m *Service) ListInvestmentListings(ctx context.Context, req *model.ListInvestmentListingsRequest) (*model.ListInvestmentListingsResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *model.CloseAccountResponse
+	var r0 *model.ListInvestmentListingsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CloseAccountRequest) (*model.CloseAccountResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListInvestmentListingsRequest) (*model.ListInvestmentListingsResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CloseAccountRequest) *model.CloseAccountResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListInvestmentListingsRequest) *model.ListInvestmentListingsResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.CloseAccountResponse)
+			r0 = ret.Get(0).(*model.ListInvestmentListingsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.CloseAccountRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ListInvestmentListingsRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -66,24 +716,24 @@ func (_m *Service) CloseAccount(ctx context.Context, req *model.CloseAccountRequ
 	return r0, r1
 }
 
-// CreateLoan provides a mock function with given fields: ctx, req
-func (_m *Service) CreateLoan(ctx context.Context, req *model.CreateLoanRequest) (*model.CreateLoanResponse, error) {
+// ListInvestmentTransfers provides a mock function with given fields: ctx, req
+func (_m *Service) ListInvestmentTransfers(ctx context.Context, req *model.ListInvestmentTransfersRequest) (*model.ListInvestmentTransfersResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *model.CreateLoanResponse
+	var r0 *model.ListInvestmentTransfersResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateLoanRequest) (*model.CreateLoanResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListInvestmentTransfersRequest) (*model.ListInvestmentTransfersResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateLoanRequest) *model.CreateLoanResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListInvestmentTransfersRequest) *model.ListInvestmentTransfersResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.CreateLoanResponse)
+			r0 = ret.Get(0).(*model.ListInvestmentTransfersResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateLoanRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ListInvestmentTransfersRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -92,24 +742,24 @@ func (_m *Service) CreateLoan(ctx context.Context, req *model.CreateLoanRequest)
 	return r0, r1
 }
 
-// DisburseLoan provides a mock function with given fields: ctx, req
-func (_m *Service) DisburseLoan(ctx context.Context, req *model.DisburseLoanRequest) (*model.DisburseLoanResponse, error) {
+// ListLoans provides a mock function with given fields: ctx, req
+func (_m *Service) ListLoans(ctx context.Context, req *model.ListLoansRequest) (*model.ListLoansResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *model.DisburseLoanResponse
+	var r0 *model.ListLoansResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.DisburseLoanRequest) (*model.DisburseLoanResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListLoansRequest) (*model.ListLoansResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.DisburseLoanRequest) *model.DisburseLoanResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListLoansRequest) *model.ListLoansResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.DisburseLoanResponse)
+			r0 = ret.Get(0).(*model.ListLoansResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.DisburseLoanRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ListLoansRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -118,24 +768,24 @@ func (_m *Service) DisburseLoan(ctx context.Context, req *model.DisburseLoanRequ
 	return r0, r1
 }
 
-// InvestInLoan provides a mock function with given fields: ctx, req
-func (_m *Service) InvestInLoan(ctx context.Context, req *model.InvestInLoanRequest) (*model.InvestInLoanResponse, error) {
+// ListMyAssignments provides a mock function with given fields: ctx, req
+func (_m *Service) ListMyAssignments(ctx context.Context, req *model.ListMyAssignmentsRequest) (*model.ListMyAssignmentsResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *model.InvestInLoanResponse
+	var r0 *model.ListMyAssignmentsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.InvestInLoanRequest) (*model.InvestInLoanResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListMyAssignmentsRequest) (*model.ListMyAssignmentsResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.InvestInLoanRequest) *model.InvestInLoanResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ListMyAssignmentsRequest) *model.ListMyAssignmentsResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.InvestInLoanResponse)
+			r0 = ret.Get(0).(*model.ListMyAssignmentsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.InvestInLoanRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ListMyAssignmentsRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -144,24 +794,24 @@ func (_m *Service) InvestInLoan(ctx context.Context, req *model.InvestInLoanRequ
 	return r0, r1
 }
 
-// IsUserExist provides a mock function with given fields: ctx, req
-func (_m *Service) IsUserExist(ctx context.Context, req *model.IsUserExistRequest) (*model.IsUserExistResponse, error) {
+// Login provides a mock function with given fields: ctx, req
+func (_m *Service) Login(ctx context.Context, req *model.LoginRequest) (*model.LoginResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *model.IsUserExistResponse
+	var r0 *model.LoginResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.IsUserExistRequest) (*model.IsUserExistResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoginRequest) (*model.LoginResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.IsUserExistRequest) *model.IsUserExistResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LoginRequest) *model.LoginResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.IsUserExistResponse)
+			r0 = ret.Get(0).(*model.LoginResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.IsUserExistRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *model.LoginRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -170,25 +820,25 @@ func (_m *Service) IsUserExist(ctx context.Context, req *model.IsUserExistReques
 	return r0, r1
 }
 
-// Jwks provides a mock function with given fields: ctx
-func (_m *Service) Jwks(ctx context.Context) ([]*model.Jwk, error) {
-	ret := _m.Called(ctx)
+// PrepayLoan provides a mock function with given fields: ctx, req
+func (_m *Service) PrepayLoan(ctx context.Context, req *model.PrepayLoanRequest) (*model.PrepayLoanResponse, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 []*model.Jwk
+	var r0 *model.PrepayLoanResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.Jwk, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PrepayLoanRequest) (*model.PrepayLoanResponse, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*model.Jwk); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PrepayLoanRequest) *model.PrepayLoanResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Jwk)
+			r0 = ret.Get(0).(*model.PrepayLoanResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PrepayLoanRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -196,24 +846,50 @@ func (_m *Service) Jwks(ctx context.Context) ([]*model.Jwk, error) {
 	return r0, r1
 }
 
-// Login provides a mock function with given fields: ctx, req
-func (_m *Service) Login(ctx context.Context, req *model.LoginRequest) (*model.LoginResponse, error) {
+// ReassignLoan provides a mock function with given fields: ctx, req
+func (_m *Service) ReassignLoan(ctx context.Context, req *model.ReassignLoanRequest) (*model.ReassignLoanResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *model.LoginResponse
+	var r0 *model.ReassignLoanResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.LoginRequest) (*model.LoginResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ReassignLoanRequest) (*model.ReassignLoanResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.LoginRequest) *model.LoginResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ReassignLoanRequest) *model.ReassignLoanResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.LoginResponse)
+			r0 = ret.Get(0).(*model.ReassignLoanResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.LoginRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ReassignLoanRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordRepayment provides a mock function with given fields: ctx, req
+func (_m *Service) RecordRepayment(ctx context.Context, req *model.RecordRepaymentRequest) (*model.RecordRepaymentResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.RecordRepaymentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RecordRepaymentRequest) (*model.RecordRepaymentResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RecordRepaymentRequest) *model.RecordRepaymentResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RecordRepaymentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.RecordRepaymentRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -274,6 +950,136 @@ func (_m *Service) Register(ctx context.Context, req *model.RegisterRequest) (*m
 	return r0, r1
 }
 
+// RejectLoan provides a mock function with given fields: ctx, req
+func (_m *Service) RejectLoan(ctx context.Context, req *model.RejectLoanRequest) (*model.RejectLoanResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.RejectLoanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RejectLoanRequest) (*model.RejectLoanResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RejectLoanRequest) *model.RejectLoanResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RejectLoanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.RejectLoanRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReleaseExpiredReservations provides a mock function with given fields: ctx
+func (_m *Service) ReleaseExpiredReservations(ctx context.Context) (*model.ReleaseExpiredReservationsResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *model.ReleaseExpiredReservationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.ReleaseExpiredReservationsResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.ReleaseExpiredReservationsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ReleaseExpiredReservationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReserveInvestment provides a mock function with given fields: ctx, req
+func (_m *Service) ReserveInvestment(ctx context.Context, req *model.ReserveInvestmentRequest) (*model.ReserveInvestmentResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.ReserveInvestmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ReserveInvestmentRequest) (*model.ReserveInvestmentResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ReserveInvestmentRequest) *model.ReserveInvestmentResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ReserveInvestmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ReserveInvestmentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestructureLoan provides a mock function with given fields: ctx, req
+func (_m *Service) RestructureLoan(ctx context.Context, req *model.RestructureLoanRequest) (*model.RestructureLoanResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.RestructureLoanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RestructureLoanRequest) (*model.RestructureLoanResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RestructureLoanRequest) *model.RestructureLoanResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RestructureLoanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.RestructureLoanRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReviewLoanRestructure provides a mock function with given fields: ctx, req
+func (_m *Service) ReviewLoanRestructure(ctx context.Context, req *model.ReviewLoanRestructureRequest) (*model.ReviewLoanRestructureResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.ReviewLoanRestructureResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ReviewLoanRestructureRequest) (*model.ReviewLoanRestructureResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ReviewLoanRestructureRequest) *model.ReviewLoanRestructureResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ReviewLoanRestructureResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ReviewLoanRestructureRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendMail provides a mock function with given fields: ctx, req
 func (_m *Service) SendMail(ctx context.Context, req *model.EmailRequest) error {
 	ret := _m.Called(ctx, req)
@@ -288,6 +1094,84 @@ func (_m *Service) SendMail(ctx context.Context, req *model.EmailRequest) error
 	return r0
 }
 
+// SetBorrowerCreditLimit provides a mock function with given fields: ctx, req
+func (_m *Service) SetBorrowerCreditLimit(ctx context.Context, req *model.SetBorrowerCreditLimitRequest) (*model.SetBorrowerCreditLimitResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.SetBorrowerCreditLimitResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetBorrowerCreditLimitRequest) (*model.SetBorrowerCreditLimitResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SetBorrowerCreditLimitRequest) *model.SetBorrowerCreditLimitResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SetBorrowerCreditLimitResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SetBorrowerCreditLimitRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrackDelinquency provides a mock function with given fields: ctx
+func (_m *Service) TrackDelinquency(ctx context.Context) (*model.TrackDelinquencyResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *model.TrackDelinquencyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.TrackDelinquencyResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.TrackDelinquencyResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TrackDelinquencyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WriteOffLoan provides a mock function with given fields: ctx, req
+func (_m *Service) WriteOffLoan(ctx context.Context, req *model.WriteOffLoanRequest) (*model.WriteOffLoanResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.WriteOffLoanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WriteOffLoanRequest) (*model.WriteOffLoanResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WriteOffLoanRequest) *model.WriteOffLoanResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WriteOffLoanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.WriteOffLoanRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewService(t interface {
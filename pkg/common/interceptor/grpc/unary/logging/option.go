@@ -49,6 +49,13 @@ func (o *options) getError(err error) (codes.Code, error, bool) {
 		return code, err, ok
 	}
 
+	// Typed errors wrapping a known error keep their own message.
+	for target, code := range o.mapError {
+		if errors.Is(err, target) {
+			return code, err, true
+		}
+	}
+
 	if ok := strings.Contains(err.Error(), "VALIDATION_ERR: "); ok {
 		return codes.InvalidArgument, errors.New(strings.ReplaceAll(err.Error(), "VALIDATION_ERR: ", "")), ok
 	}
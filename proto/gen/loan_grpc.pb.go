@@ -19,10 +19,39 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	LoanService_CreateLoan_FullMethodName   = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/CreateLoan"
-	LoanService_ApproveLoan_FullMethodName  = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/ApproveLoan"
-	LoanService_InvestInLoan_FullMethodName = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/InvestInLoan"
-	LoanService_DisburseLoan_FullMethodName = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/DisburseLoan"
+	LoanService_CreateLoan_FullMethodName              = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/CreateLoan"
+	LoanService_ApproveLoan_FullMethodName             = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/ApproveLoan"
+	LoanService_RejectLoan_FullMethodName              = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/RejectLoan"
+	LoanService_InvestInLoan_FullMethodName            = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/InvestInLoan"
+	LoanService_DisburseLoan_FullMethodName            = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/DisburseLoan"
+	LoanService_CancelLoan_FullMethodName              = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/CancelLoan"
+	LoanService_GetRepaymentSchedule_FullMethodName    = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/GetRepaymentSchedule"
+	LoanService_RecordRepayment_FullMethodName         = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/RecordRepayment"
+	LoanService_GetLoanDelinquency_FullMethodName      = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/GetLoanDelinquency"
+	LoanService_WriteOffLoan_FullMethodName            = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/WriteOffLoan"
+	LoanService_PrepayLoan_FullMethodName              = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/PrepayLoan"
+	LoanService_GetPayoffQuote_FullMethodName          = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/GetPayoffQuote"
+	LoanService_RestructureLoan_FullMethodName         = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/RestructureLoan"
+	LoanService_ReviewLoanRestructure_FullMethodName   = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/ReviewLoanRestructure"
+	LoanService_GetBorrowerCreditLimit_FullMethodName  = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/GetBorrowerCreditLimit"
+	LoanService_SetBorrowerCreditLimit_FullMethodName  = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/SetBorrowerCreditLimit"
+	LoanService_CreateAutoInvestRule_FullMethodName    = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/CreateAutoInvestRule"
+	LoanService_ListAutoInvestRules_FullMethodName     = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/ListAutoInvestRules"
+	LoanService_DeleteAutoInvestRule_FullMethodName    = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/DeleteAutoInvestRule"
+	LoanService_ReserveInvestment_FullMethodName       = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/ReserveInvestment"
+	LoanService_ConfirmInvestment_FullMethodName       = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/ConfirmInvestment"
+	LoanService_CancelInvestment_FullMethodName        = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/CancelInvestment"
+	LoanService_CreateInvestmentListing_FullMethodName = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/CreateInvestmentListing"
+	LoanService_CancelInvestmentListing_FullMethodName = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/CancelInvestmentListing"
+	LoanService_BuyInvestmentListing_FullMethodName    = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/BuyInvestmentListing"
+	LoanService_ListInvestmentListings_FullMethodName  = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/ListInvestmentListings"
+	LoanService_ListInvestmentTransfers_FullMethodName = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/ListInvestmentTransfers"
+	LoanService_GetAccruedInterest_FullMethodName      = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/GetAccruedInterest"
+	LoanService_GetLoan_FullMethodName                 = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/GetLoan"
+	LoanService_ListLoans_FullMethodName               = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/ListLoans"
+	LoanService_GetPortfolio_FullMethodName            = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/GetPortfolio"
+	LoanService_ListMyAssignments_FullMethodName       = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/ListMyAssignments"
+	LoanService_ReassignLoan_FullMethodName            = "/grpcPostgresAuthUserAsymmetric.loan.LoanService/ReassignLoan"
 )
 
 // LoanServiceClient is the client API for LoanService service.
@@ -31,8 +60,37 @@ const (
 type LoanServiceClient interface {
 	CreateLoan(ctx context.Context, in *CreateLoanRequest, opts ...grpc.CallOption) (*CreateLoanResponse, error)
 	ApproveLoan(ctx context.Context, in *ApproveLoanRequest, opts ...grpc.CallOption) (*ApproveLoanResponse, error)
+	RejectLoan(ctx context.Context, in *RejectLoanRequest, opts ...grpc.CallOption) (*RejectLoanResponse, error)
 	InvestInLoan(ctx context.Context, in *InvestInLoanRequest, opts ...grpc.CallOption) (*InvestInLoanResponse, error)
 	DisburseLoan(ctx context.Context, in *DisburseLoanRequest, opts ...grpc.CallOption) (*DisburseLoanResponse, error)
+	CancelLoan(ctx context.Context, in *CancelLoanRequest, opts ...grpc.CallOption) (*CancelLoanResponse, error)
+	GetRepaymentSchedule(ctx context.Context, in *GetRepaymentScheduleRequest, opts ...grpc.CallOption) (*GetRepaymentScheduleResponse, error)
+	RecordRepayment(ctx context.Context, in *RecordRepaymentRequest, opts ...grpc.CallOption) (*RecordRepaymentResponse, error)
+	GetLoanDelinquency(ctx context.Context, in *GetLoanDelinquencyRequest, opts ...grpc.CallOption) (*GetLoanDelinquencyResponse, error)
+	WriteOffLoan(ctx context.Context, in *WriteOffLoanRequest, opts ...grpc.CallOption) (*WriteOffLoanResponse, error)
+	PrepayLoan(ctx context.Context, in *PrepayLoanRequest, opts ...grpc.CallOption) (*PrepayLoanResponse, error)
+	GetPayoffQuote(ctx context.Context, in *GetPayoffQuoteRequest, opts ...grpc.CallOption) (*GetPayoffQuoteResponse, error)
+	RestructureLoan(ctx context.Context, in *RestructureLoanRequest, opts ...grpc.CallOption) (*RestructureLoanResponse, error)
+	ReviewLoanRestructure(ctx context.Context, in *ReviewLoanRestructureRequest, opts ...grpc.CallOption) (*ReviewLoanRestructureResponse, error)
+	GetBorrowerCreditLimit(ctx context.Context, in *GetBorrowerCreditLimitRequest, opts ...grpc.CallOption) (*GetBorrowerCreditLimitResponse, error)
+	SetBorrowerCreditLimit(ctx context.Context, in *SetBorrowerCreditLimitRequest, opts ...grpc.CallOption) (*SetBorrowerCreditLimitResponse, error)
+	CreateAutoInvestRule(ctx context.Context, in *CreateAutoInvestRuleRequest, opts ...grpc.CallOption) (*CreateAutoInvestRuleResponse, error)
+	ListAutoInvestRules(ctx context.Context, in *ListAutoInvestRulesRequest, opts ...grpc.CallOption) (*ListAutoInvestRulesResponse, error)
+	DeleteAutoInvestRule(ctx context.Context, in *DeleteAutoInvestRuleRequest, opts ...grpc.CallOption) (*DeleteAutoInvestRuleResponse, error)
+	ReserveInvestment(ctx context.Context, in *ReserveInvestmentRequest, opts ...grpc.CallOption) (*ReserveInvestmentResponse, error)
+	ConfirmInvestment(ctx context.Context, in *ConfirmInvestmentRequest, opts ...grpc.CallOption) (*ConfirmInvestmentResponse, error)
+	CancelInvestment(ctx context.Context, in *CancelInvestmentRequest, opts ...grpc.CallOption) (*CancelInvestmentResponse, error)
+	CreateInvestmentListing(ctx context.Context, in *CreateInvestmentListingRequest, opts ...grpc.CallOption) (*CreateInvestmentListingResponse, error)
+	CancelInvestmentListing(ctx context.Context, in *CancelInvestmentListingRequest, opts ...grpc.CallOption) (*CancelInvestmentListingResponse, error)
+	BuyInvestmentListing(ctx context.Context, in *BuyInvestmentListingRequest, opts ...grpc.CallOption) (*BuyInvestmentListingResponse, error)
+	ListInvestmentListings(ctx context.Context, in *ListInvestmentListingsRequest, opts ...grpc.CallOption) (*ListInvestmentListingsResponse, error)
+	ListInvestmentTransfers(ctx context.Context, in *ListInvestmentTransfersRequest, opts ...grpc.CallOption) (*ListInvestmentTransfersResponse, error)
+	GetAccruedInterest(ctx context.Context, in *GetAccruedInterestRequest, opts ...grpc.CallOption) (*GetAccruedInterestResponse, error)
+	GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*GetLoanResponse, error)
+	ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
+	GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error)
+	ListMyAssignments(ctx context.Context, in *ListMyAssignmentsRequest, opts ...grpc.CallOption) (*ListMyAssignmentsResponse, error)
+	ReassignLoan(ctx context.Context, in *ReassignLoanRequest, opts ...grpc.CallOption) (*ReassignLoanResponse, error)
 }
 
 type loanServiceClient struct {
@@ -61,6 +119,15 @@ func (c *loanServiceClient) ApproveLoan(ctx context.Context, in *ApproveLoanRequ
 	return out, nil
 }
 
+func (c *loanServiceClient) RejectLoan(ctx context.Context, in *RejectLoanRequest, opts ...grpc.CallOption) (*RejectLoanResponse, error) {
+	out := new(RejectLoanResponse)
+	err := c.cc.Invoke(ctx, LoanService_RejectLoan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *loanServiceClient) InvestInLoan(ctx context.Context, in *InvestInLoanRequest, opts ...grpc.CallOption) (*InvestInLoanResponse, error) {
 	out := new(InvestInLoanResponse)
 	err := c.cc.Invoke(ctx, LoanService_InvestInLoan_FullMethodName, in, out, opts...)
@@ -79,14 +146,295 @@ func (c *loanServiceClient) DisburseLoan(ctx context.Context, in *DisburseLoanRe
 	return out, nil
 }
 
+func (c *loanServiceClient) CancelLoan(ctx context.Context, in *CancelLoanRequest, opts ...grpc.CallOption) (*CancelLoanResponse, error) {
+	out := new(CancelLoanResponse)
+	err := c.cc.Invoke(ctx, LoanService_CancelLoan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) GetRepaymentSchedule(ctx context.Context, in *GetRepaymentScheduleRequest, opts ...grpc.CallOption) (*GetRepaymentScheduleResponse, error) {
+	out := new(GetRepaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, LoanService_GetRepaymentSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) RecordRepayment(ctx context.Context, in *RecordRepaymentRequest, opts ...grpc.CallOption) (*RecordRepaymentResponse, error) {
+	out := new(RecordRepaymentResponse)
+	err := c.cc.Invoke(ctx, LoanService_RecordRepayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) GetLoanDelinquency(ctx context.Context, in *GetLoanDelinquencyRequest, opts ...grpc.CallOption) (*GetLoanDelinquencyResponse, error) {
+	out := new(GetLoanDelinquencyResponse)
+	err := c.cc.Invoke(ctx, LoanService_GetLoanDelinquency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) WriteOffLoan(ctx context.Context, in *WriteOffLoanRequest, opts ...grpc.CallOption) (*WriteOffLoanResponse, error) {
+	out := new(WriteOffLoanResponse)
+	err := c.cc.Invoke(ctx, LoanService_WriteOffLoan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) PrepayLoan(ctx context.Context, in *PrepayLoanRequest, opts ...grpc.CallOption) (*PrepayLoanResponse, error) {
+	out := new(PrepayLoanResponse)
+	err := c.cc.Invoke(ctx, LoanService_PrepayLoan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) GetPayoffQuote(ctx context.Context, in *GetPayoffQuoteRequest, opts ...grpc.CallOption) (*GetPayoffQuoteResponse, error) {
+	out := new(GetPayoffQuoteResponse)
+	err := c.cc.Invoke(ctx, LoanService_GetPayoffQuote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) RestructureLoan(ctx context.Context, in *RestructureLoanRequest, opts ...grpc.CallOption) (*RestructureLoanResponse, error) {
+	out := new(RestructureLoanResponse)
+	err := c.cc.Invoke(ctx, LoanService_RestructureLoan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ReviewLoanRestructure(ctx context.Context, in *ReviewLoanRestructureRequest, opts ...grpc.CallOption) (*ReviewLoanRestructureResponse, error) {
+	out := new(ReviewLoanRestructureResponse)
+	err := c.cc.Invoke(ctx, LoanService_ReviewLoanRestructure_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) GetBorrowerCreditLimit(ctx context.Context, in *GetBorrowerCreditLimitRequest, opts ...grpc.CallOption) (*GetBorrowerCreditLimitResponse, error) {
+	out := new(GetBorrowerCreditLimitResponse)
+	err := c.cc.Invoke(ctx, LoanService_GetBorrowerCreditLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) SetBorrowerCreditLimit(ctx context.Context, in *SetBorrowerCreditLimitRequest, opts ...grpc.CallOption) (*SetBorrowerCreditLimitResponse, error) {
+	out := new(SetBorrowerCreditLimitResponse)
+	err := c.cc.Invoke(ctx, LoanService_SetBorrowerCreditLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) CreateAutoInvestRule(ctx context.Context, in *CreateAutoInvestRuleRequest, opts ...grpc.CallOption) (*CreateAutoInvestRuleResponse, error) {
+	out := new(CreateAutoInvestRuleResponse)
+	err := c.cc.Invoke(ctx, LoanService_CreateAutoInvestRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ListAutoInvestRules(ctx context.Context, in *ListAutoInvestRulesRequest, opts ...grpc.CallOption) (*ListAutoInvestRulesResponse, error) {
+	out := new(ListAutoInvestRulesResponse)
+	err := c.cc.Invoke(ctx, LoanService_ListAutoInvestRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) DeleteAutoInvestRule(ctx context.Context, in *DeleteAutoInvestRuleRequest, opts ...grpc.CallOption) (*DeleteAutoInvestRuleResponse, error) {
+	out := new(DeleteAutoInvestRuleResponse)
+	err := c.cc.Invoke(ctx, LoanService_DeleteAutoInvestRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ReserveInvestment(ctx context.Context, in *ReserveInvestmentRequest, opts ...grpc.CallOption) (*ReserveInvestmentResponse, error) {
+	out := new(ReserveInvestmentResponse)
+	err := c.cc.Invoke(ctx, LoanService_ReserveInvestment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ConfirmInvestment(ctx context.Context, in *ConfirmInvestmentRequest, opts ...grpc.CallOption) (*ConfirmInvestmentResponse, error) {
+	out := new(ConfirmInvestmentResponse)
+	err := c.cc.Invoke(ctx, LoanService_ConfirmInvestment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) CancelInvestment(ctx context.Context, in *CancelInvestmentRequest, opts ...grpc.CallOption) (*CancelInvestmentResponse, error) {
+	out := new(CancelInvestmentResponse)
+	err := c.cc.Invoke(ctx, LoanService_CancelInvestment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) CreateInvestmentListing(ctx context.Context, in *CreateInvestmentListingRequest, opts ...grpc.CallOption) (*CreateInvestmentListingResponse, error) {
+	out := new(CreateInvestmentListingResponse)
+	err := c.cc.Invoke(ctx, LoanService_CreateInvestmentListing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) CancelInvestmentListing(ctx context.Context, in *CancelInvestmentListingRequest, opts ...grpc.CallOption) (*CancelInvestmentListingResponse, error) {
+	out := new(CancelInvestmentListingResponse)
+	err := c.cc.Invoke(ctx, LoanService_CancelInvestmentListing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) BuyInvestmentListing(ctx context.Context, in *BuyInvestmentListingRequest, opts ...grpc.CallOption) (*BuyInvestmentListingResponse, error) {
+	out := new(BuyInvestmentListingResponse)
+	err := c.cc.Invoke(ctx, LoanService_BuyInvestmentListing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ListInvestmentListings(ctx context.Context, in *ListInvestmentListingsRequest, opts ...grpc.CallOption) (*ListInvestmentListingsResponse, error) {
+	out := new(ListInvestmentListingsResponse)
+	err := c.cc.Invoke(ctx, LoanService_ListInvestmentListings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ListInvestmentTransfers(ctx context.Context, in *ListInvestmentTransfersRequest, opts ...grpc.CallOption) (*ListInvestmentTransfersResponse, error) {
+	out := new(ListInvestmentTransfersResponse)
+	err := c.cc.Invoke(ctx, LoanService_ListInvestmentTransfers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) GetAccruedInterest(ctx context.Context, in *GetAccruedInterestRequest, opts ...grpc.CallOption) (*GetAccruedInterestResponse, error) {
+	out := new(GetAccruedInterestResponse)
+	err := c.cc.Invoke(ctx, LoanService_GetAccruedInterest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*GetLoanResponse, error) {
+	out := new(GetLoanResponse)
+	err := c.cc.Invoke(ctx, LoanService_GetLoan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error) {
+	out := new(ListLoansResponse)
+	err := c.cc.Invoke(ctx, LoanService_ListLoans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error) {
+	out := new(GetPortfolioResponse)
+	err := c.cc.Invoke(ctx, LoanService_GetPortfolio_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ListMyAssignments(ctx context.Context, in *ListMyAssignmentsRequest, opts ...grpc.CallOption) (*ListMyAssignmentsResponse, error) {
+	out := new(ListMyAssignmentsResponse)
+	err := c.cc.Invoke(ctx, LoanService_ListMyAssignments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ReassignLoan(ctx context.Context, in *ReassignLoanRequest, opts ...grpc.CallOption) (*ReassignLoanResponse, error) {
+	out := new(ReassignLoanResponse)
+	err := c.cc.Invoke(ctx, LoanService_ReassignLoan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoanServiceServer is the server API for LoanService service.
 // All implementations should embed UnimplementedLoanServiceServer
 // for forward compatibility
 type LoanServiceServer interface {
 	CreateLoan(context.Context, *CreateLoanRequest) (*CreateLoanResponse, error)
 	ApproveLoan(context.Context, *ApproveLoanRequest) (*ApproveLoanResponse, error)
+	RejectLoan(context.Context, *RejectLoanRequest) (*RejectLoanResponse, error)
 	InvestInLoan(context.Context, *InvestInLoanRequest) (*InvestInLoanResponse, error)
 	DisburseLoan(context.Context, *DisburseLoanRequest) (*DisburseLoanResponse, error)
+	CancelLoan(context.Context, *CancelLoanRequest) (*CancelLoanResponse, error)
+	GetRepaymentSchedule(context.Context, *GetRepaymentScheduleRequest) (*GetRepaymentScheduleResponse, error)
+	RecordRepayment(context.Context, *RecordRepaymentRequest) (*RecordRepaymentResponse, error)
+	GetLoanDelinquency(context.Context, *GetLoanDelinquencyRequest) (*GetLoanDelinquencyResponse, error)
+	WriteOffLoan(context.Context, *WriteOffLoanRequest) (*WriteOffLoanResponse, error)
+	PrepayLoan(context.Context, *PrepayLoanRequest) (*PrepayLoanResponse, error)
+	GetPayoffQuote(context.Context, *GetPayoffQuoteRequest) (*GetPayoffQuoteResponse, error)
+	RestructureLoan(context.Context, *RestructureLoanRequest) (*RestructureLoanResponse, error)
+	ReviewLoanRestructure(context.Context, *ReviewLoanRestructureRequest) (*ReviewLoanRestructureResponse, error)
+	GetBorrowerCreditLimit(context.Context, *GetBorrowerCreditLimitRequest) (*GetBorrowerCreditLimitResponse, error)
+	SetBorrowerCreditLimit(context.Context, *SetBorrowerCreditLimitRequest) (*SetBorrowerCreditLimitResponse, error)
+	CreateAutoInvestRule(context.Context, *CreateAutoInvestRuleRequest) (*CreateAutoInvestRuleResponse, error)
+	ListAutoInvestRules(context.Context, *ListAutoInvestRulesRequest) (*ListAutoInvestRulesResponse, error)
+	DeleteAutoInvestRule(context.Context, *DeleteAutoInvestRuleRequest) (*DeleteAutoInvestRuleResponse, error)
+	ReserveInvestment(context.Context, *ReserveInvestmentRequest) (*ReserveInvestmentResponse, error)
+	ConfirmInvestment(context.Context, *ConfirmInvestmentRequest) (*ConfirmInvestmentResponse, error)
+	CancelInvestment(context.Context, *CancelInvestmentRequest) (*CancelInvestmentResponse, error)
+	CreateInvestmentListing(context.Context, *CreateInvestmentListingRequest) (*CreateInvestmentListingResponse, error)
+	CancelInvestmentListing(context.Context, *CancelInvestmentListingRequest) (*CancelInvestmentListingResponse, error)
+	BuyInvestmentListing(context.Context, *BuyInvestmentListingRequest) (*BuyInvestmentListingResponse, error)
+	ListInvestmentListings(context.Context, *ListInvestmentListingsRequest) (*ListInvestmentListingsResponse, error)
+	ListInvestmentTransfers(context.Context, *ListInvestmentTransfersRequest) (*ListInvestmentTransfersResponse, error)
+	GetAccruedInterest(context.Context, *GetAccruedInterestRequest) (*GetAccruedInterestResponse, error)
+	GetLoan(context.Context, *GetLoanRequest) (*GetLoanResponse, error)
+	ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error)
+	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
+	ListMyAssignments(context.Context, *ListMyAssignmentsRequest) (*ListMyAssignmentsResponse, error)
+	ReassignLoan(context.Context, *ReassignLoanRequest) (*ReassignLoanResponse, error)
 }
 
 // UnimplementedLoanServiceServer should be embedded to have forward compatible implementations.
@@ -99,12 +447,99 @@ func (UnimplementedLoanServiceServer) CreateLoan(context.Context, *CreateLoanReq
 func (UnimplementedLoanServiceServer) ApproveLoan(context.Context, *ApproveLoanRequest) (*ApproveLoanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApproveLoan not implemented")
 }
+func (UnimplementedLoanServiceServer) RejectLoan(context.Context, *RejectLoanRequest) (*RejectLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectLoan not implemented")
+}
 func (UnimplementedLoanServiceServer) InvestInLoan(context.Context, *InvestInLoanRequest) (*InvestInLoanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvestInLoan not implemented")
 }
 func (UnimplementedLoanServiceServer) DisburseLoan(context.Context, *DisburseLoanRequest) (*DisburseLoanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisburseLoan not implemented")
 }
+func (UnimplementedLoanServiceServer) CancelLoan(context.Context, *CancelLoanRequest) (*CancelLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) GetRepaymentSchedule(context.Context, *GetRepaymentScheduleRequest) (*GetRepaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepaymentSchedule not implemented")
+}
+func (UnimplementedLoanServiceServer) RecordRepayment(context.Context, *RecordRepaymentRequest) (*RecordRepaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordRepayment not implemented")
+}
+func (UnimplementedLoanServiceServer) GetLoanDelinquency(context.Context, *GetLoanDelinquencyRequest) (*GetLoanDelinquencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoanDelinquency not implemented")
+}
+func (UnimplementedLoanServiceServer) WriteOffLoan(context.Context, *WriteOffLoanRequest) (*WriteOffLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteOffLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) PrepayLoan(context.Context, *PrepayLoanRequest) (*PrepayLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepayLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) GetPayoffQuote(context.Context, *GetPayoffQuoteRequest) (*GetPayoffQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayoffQuote not implemented")
+}
+func (UnimplementedLoanServiceServer) RestructureLoan(context.Context, *RestructureLoanRequest) (*RestructureLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestructureLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) ReviewLoanRestructure(context.Context, *ReviewLoanRestructureRequest) (*ReviewLoanRestructureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewLoanRestructure not implemented")
+}
+func (UnimplementedLoanServiceServer) GetBorrowerCreditLimit(context.Context, *GetBorrowerCreditLimitRequest) (*GetBorrowerCreditLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBorrowerCreditLimit not implemented")
+}
+func (UnimplementedLoanServiceServer) SetBorrowerCreditLimit(context.Context, *SetBorrowerCreditLimitRequest) (*SetBorrowerCreditLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBorrowerCreditLimit not implemented")
+}
+func (UnimplementedLoanServiceServer) CreateAutoInvestRule(context.Context, *CreateAutoInvestRuleRequest) (*CreateAutoInvestRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAutoInvestRule not implemented")
+}
+func (UnimplementedLoanServiceServer) ListAutoInvestRules(context.Context, *ListAutoInvestRulesRequest) (*ListAutoInvestRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAutoInvestRules not implemented")
+}
+func (UnimplementedLoanServiceServer) DeleteAutoInvestRule(context.Context, *DeleteAutoInvestRuleRequest) (*DeleteAutoInvestRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAutoInvestRule not implemented")
+}
+func (UnimplementedLoanServiceServer) ReserveInvestment(context.Context, *ReserveInvestmentRequest) (*ReserveInvestmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveInvestment not implemented")
+}
+func (UnimplementedLoanServiceServer) ConfirmInvestment(context.Context, *ConfirmInvestmentRequest) (*ConfirmInvestmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmInvestment not implemented")
+}
+func (UnimplementedLoanServiceServer) CancelInvestment(context.Context, *CancelInvestmentRequest) (*CancelInvestmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelInvestment not implemented")
+}
+func (UnimplementedLoanServiceServer) CreateInvestmentListing(context.Context, *CreateInvestmentListingRequest) (*CreateInvestmentListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvestmentListing not implemented")
+}
+func (UnimplementedLoanServiceServer) CancelInvestmentListing(context.Context, *CancelInvestmentListingRequest) (*CancelInvestmentListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelInvestmentListing not implemented")
+}
+func (UnimplementedLoanServiceServer) BuyInvestmentListing(context.Context, *BuyInvestmentListingRequest) (*BuyInvestmentListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyInvestmentListing not implemented")
+}
+func (UnimplementedLoanServiceServer) ListInvestmentListings(context.Context, *ListInvestmentListingsRequest) (*ListInvestmentListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvestmentListings not implemented")
+}
+func (UnimplementedLoanServiceServer) ListInvestmentTransfers(context.Context, *ListInvestmentTransfersRequest) (*ListInvestmentTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvestmentTransfers not implemented")
+}
+func (UnimplementedLoanServiceServer) GetAccruedInterest(context.Context, *GetAccruedInterestRequest) (*GetAccruedInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccruedInterest not implemented")
+}
+func (UnimplementedLoanServiceServer) GetLoan(context.Context, *GetLoanRequest) (*GetLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoans not implemented")
+}
+func (UnimplementedLoanServiceServer) GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+}
+func (UnimplementedLoanServiceServer) ListMyAssignments(context.Context, *ListMyAssignmentsRequest) (*ListMyAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyAssignments not implemented")
+}
+func (UnimplementedLoanServiceServer) ReassignLoan(context.Context, *ReassignLoanRequest) (*ReassignLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignLoan not implemented")
+}
 
 // UnsafeLoanServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to LoanServiceServer will
@@ -153,6 +588,24 @@ func _LoanService_ApproveLoan_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoanService_RejectLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).RejectLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_RejectLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).RejectLoan(ctx, req.(*RejectLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LoanService_InvestInLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InvestInLoanRequest)
 	if err := dec(in); err != nil {
@@ -189,28 +642,648 @@ func _LoanService_DisburseLoan_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-// LoanService_ServiceDesc is the grpc.ServiceDesc for LoanService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var LoanService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "grpcPostgresAuthUserAsymmetric.loan.LoanService",
-	HandlerType: (*LoanServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateLoan",
-			Handler:    _LoanService_CreateLoan_Handler,
-		},
-		{
-			MethodName: "ApproveLoan",
-			Handler:    _LoanService_ApproveLoan_Handler,
-		},
-		{
-			MethodName: "InvestInLoan",
-			Handler:    _LoanService_InvestInLoan_Handler,
-		},
-		{
-			MethodName: "DisburseLoan",
-			Handler:    _LoanService_DisburseLoan_Handler,
+func _LoanService_CancelLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).CancelLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_CancelLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).CancelLoan(ctx, req.(*CancelLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_GetRepaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepaymentScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).GetRepaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_GetRepaymentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).GetRepaymentSchedule(ctx, req.(*GetRepaymentScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_RecordRepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRepaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).RecordRepayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_RecordRepayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).RecordRepayment(ctx, req.(*RecordRepaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_GetLoanDelinquency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanDelinquencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).GetLoanDelinquency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_GetLoanDelinquency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).GetLoanDelinquency(ctx, req.(*GetLoanDelinquencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_WriteOffLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteOffLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).WriteOffLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_WriteOffLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).WriteOffLoan(ctx, req.(*WriteOffLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_PrepayLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepayLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).PrepayLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_PrepayLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).PrepayLoan(ctx, req.(*PrepayLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_GetPayoffQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoffQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).GetPayoffQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_GetPayoffQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).GetPayoffQuote(ctx, req.(*GetPayoffQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_RestructureLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestructureLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).RestructureLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_RestructureLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).RestructureLoan(ctx, req.(*RestructureLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ReviewLoanRestructure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewLoanRestructureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ReviewLoanRestructure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_ReviewLoanRestructure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ReviewLoanRestructure(ctx, req.(*ReviewLoanRestructureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_GetBorrowerCreditLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBorrowerCreditLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).GetBorrowerCreditLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_GetBorrowerCreditLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).GetBorrowerCreditLimit(ctx, req.(*GetBorrowerCreditLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_SetBorrowerCreditLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBorrowerCreditLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).SetBorrowerCreditLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_SetBorrowerCreditLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).SetBorrowerCreditLimit(ctx, req.(*SetBorrowerCreditLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_CreateAutoInvestRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAutoInvestRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).CreateAutoInvestRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_CreateAutoInvestRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).CreateAutoInvestRule(ctx, req.(*CreateAutoInvestRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ListAutoInvestRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAutoInvestRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ListAutoInvestRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_ListAutoInvestRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ListAutoInvestRules(ctx, req.(*ListAutoInvestRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_DeleteAutoInvestRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAutoInvestRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).DeleteAutoInvestRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_DeleteAutoInvestRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).DeleteAutoInvestRule(ctx, req.(*DeleteAutoInvestRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ReserveInvestment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveInvestmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ReserveInvestment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_ReserveInvestment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ReserveInvestment(ctx, req.(*ReserveInvestmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ConfirmInvestment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmInvestmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ConfirmInvestment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_ConfirmInvestment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ConfirmInvestment(ctx, req.(*ConfirmInvestmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_CancelInvestment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelInvestmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).CancelInvestment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_CancelInvestment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).CancelInvestment(ctx, req.(*CancelInvestmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_CreateInvestmentListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvestmentListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).CreateInvestmentListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_CreateInvestmentListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).CreateInvestmentListing(ctx, req.(*CreateInvestmentListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_CancelInvestmentListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelInvestmentListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).CancelInvestmentListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_CancelInvestmentListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).CancelInvestmentListing(ctx, req.(*CancelInvestmentListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_BuyInvestmentListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyInvestmentListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).BuyInvestmentListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_BuyInvestmentListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).BuyInvestmentListing(ctx, req.(*BuyInvestmentListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ListInvestmentListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvestmentListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ListInvestmentListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_ListInvestmentListings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ListInvestmentListings(ctx, req.(*ListInvestmentListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ListInvestmentTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvestmentTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ListInvestmentTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_ListInvestmentTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ListInvestmentTransfers(ctx, req.(*ListInvestmentTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_GetAccruedInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccruedInterestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).GetAccruedInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_GetAccruedInterest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).GetAccruedInterest(ctx, req.(*GetAccruedInterestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_GetLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).GetLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_GetLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).GetLoan(ctx, req.(*GetLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ListLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ListLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_ListLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ListLoans(ctx, req.(*ListLoansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).GetPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_GetPortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ListMyAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ListMyAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_ListMyAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ListMyAssignments(ctx, req.(*ListMyAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ReassignLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ReassignLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_ReassignLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ReassignLoan(ctx, req.(*ReassignLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LoanService_ServiceDesc is the grpc.ServiceDesc for LoanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LoanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "grpcPostgresAuthUserAsymmetric.loan.LoanService",
+	HandlerType: (*LoanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLoan",
+			Handler:    _LoanService_CreateLoan_Handler,
+		},
+		{
+			MethodName: "ApproveLoan",
+			Handler:    _LoanService_ApproveLoan_Handler,
+		},
+		{
+			MethodName: "RejectLoan",
+			Handler:    _LoanService_RejectLoan_Handler,
+		},
+		{
+			MethodName: "InvestInLoan",
+			Handler:    _LoanService_InvestInLoan_Handler,
+		},
+		{
+			MethodName: "DisburseLoan",
+			Handler:    _LoanService_DisburseLoan_Handler,
+		},
+		{
+			MethodName: "CancelLoan",
+			Handler:    _LoanService_CancelLoan_Handler,
+		},
+		{
+			MethodName: "GetRepaymentSchedule",
+			Handler:    _LoanService_GetRepaymentSchedule_Handler,
+		},
+		{
+			MethodName: "RecordRepayment",
+			Handler:    _LoanService_RecordRepayment_Handler,
+		},
+		{
+			MethodName: "GetLoanDelinquency",
+			Handler:    _LoanService_GetLoanDelinquency_Handler,
+		},
+		{
+			MethodName: "WriteOffLoan",
+			Handler:    _LoanService_WriteOffLoan_Handler,
+		},
+		{
+			MethodName: "PrepayLoan",
+			Handler:    _LoanService_PrepayLoan_Handler,
+		},
+		{
+			MethodName: "GetPayoffQuote",
+			Handler:    _LoanService_GetPayoffQuote_Handler,
+		},
+		{
+			MethodName: "RestructureLoan",
+			Handler:    _LoanService_RestructureLoan_Handler,
+		},
+		{
+			MethodName: "ReviewLoanRestructure",
+			Handler:    _LoanService_ReviewLoanRestructure_Handler,
+		},
+		{
+			MethodName: "GetBorrowerCreditLimit",
+			Handler:    _LoanService_GetBorrowerCreditLimit_Handler,
+		},
+		{
+			MethodName: "SetBorrowerCreditLimit",
+			Handler:    _LoanService_SetBorrowerCreditLimit_Handler,
+		},
+		{
+			MethodName: "CreateAutoInvestRule",
+			Handler:    _LoanService_CreateAutoInvestRule_Handler,
+		},
+		{
+			MethodName: "ListAutoInvestRules",
+			Handler:    _LoanService_ListAutoInvestRules_Handler,
+		},
+		{
+			MethodName: "DeleteAutoInvestRule",
+			Handler:    _LoanService_DeleteAutoInvestRule_Handler,
+		},
+		{
+			MethodName: "ReserveInvestment",
+			Handler:    _LoanService_ReserveInvestment_Handler,
+		},
+		{
+			MethodName: "ConfirmInvestment",
+			Handler:    _LoanService_ConfirmInvestment_Handler,
+		},
+		{
+			MethodName: "CancelInvestment",
+			Handler:    _LoanService_CancelInvestment_Handler,
+		},
+		{
+			MethodName: "CreateInvestmentListing",
+			Handler:    _LoanService_CreateInvestmentListing_Handler,
+		},
+		{
+			MethodName: "CancelInvestmentListing",
+			Handler:    _LoanService_CancelInvestmentListing_Handler,
+		},
+		{
+			MethodName: "BuyInvestmentListing",
+			Handler:    _LoanService_BuyInvestmentListing_Handler,
+		},
+		{
+			MethodName: "ListInvestmentListings",
+			Handler:    _LoanService_ListInvestmentListings_Handler,
+		},
+		{
+			MethodName: "ListInvestmentTransfers",
+			Handler:    _LoanService_ListInvestmentTransfers_Handler,
+		},
+		{
+			MethodName: "GetAccruedInterest",
+			Handler:    _LoanService_GetAccruedInterest_Handler,
+		},
+		{
+			MethodName: "GetLoan",
+			Handler:    _LoanService_GetLoan_Handler,
+		},
+		{
+			MethodName: "ListLoans",
+			Handler:    _LoanService_ListLoans_Handler,
+		},
+		{
+			MethodName: "GetPortfolio",
+			Handler:    _LoanService_GetPortfolio_Handler,
+		},
+		{
+			MethodName: "ListMyAssignments",
+			Handler:    _LoanService_ListMyAssignments_Handler,
+		},
+		{
+			MethodName: "ReassignLoan",
+			Handler:    _LoanService_ReassignLoan_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},